@@ -73,6 +73,7 @@ func FavoriteList(ctx context.Context, c *app.RequestContext) {
 	req := &kitex.FavoriteListRequest{
 		UserId: uid,
 		Token:  token,
+		Cursor: c.Query("cursor"),
 	}
 	res, _ := rpc.FavoriteList(ctx, req)
 	if res.StatusCode == -1 {
@@ -89,6 +90,7 @@ func FavoriteList(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  res.StatusMsg,
 		},
-		VideoList: res.VideoList,
+		VideoList:  res.VideoList,
+		NextCursor: res.NextCursor,
 	})
 }
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/cmd/api/rpc"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/response"
+	kitex "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/search"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func SearchUser(ctx context.Context, c *app.RequestContext) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusOK, response.SearchUser{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "keyword 不能为空",
+			},
+		})
+		return
+	}
+	offset, _ := strconv.ParseInt(c.Query("offset"), 10, 64)
+
+	req := &kitex.SearchUserRequest{
+		Token:   c.Query("token"),
+		Keyword: keyword,
+		Offset:  offset,
+	}
+	res, _ := rpc.SearchUser(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.SearchUser{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.SearchUser{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		UserList:   res.UserList,
+		NextOffset: res.NextOffset,
+	})
+}
+
+func SearchVideo(ctx context.Context, c *app.RequestContext) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusOK, response.SearchVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "keyword 不能为空",
+			},
+		})
+		return
+	}
+	offset, _ := strconv.ParseInt(c.Query("offset"), 10, 64)
+
+	req := &kitex.SearchVideoRequest{
+		Token:   c.Query("token"),
+		Keyword: keyword,
+		Offset:  offset,
+	}
+	res, _ := rpc.SearchVideo(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.SearchVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.SearchVideo{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		VideoList:  res.VideoList,
+		NextOffset: res.NextOffset,
+	})
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// LocalStorage 返回本地存储中的对象，临时链接需校验签名，允许匿名读取的对象可直接访问
+func LocalStorage(ctx context.Context, c *app.RequestContext) {
+	bucket := c.Param("bucket")
+	object := strings.TrimPrefix(c.Param("object"), "/")
+	path, err := minio.ServeLocalFile(bucket, object, c.Query("expires"), c.Query("signature"))
+	switch {
+	case errors.Is(err, minio.ErrNotLocalStore):
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	case errors.Is(err, minio.ErrInvalidObjectName):
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	case errors.Is(err, minio.ErrInvalidSignature), errors.Is(err, minio.ErrSignatureExpired):
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	case err != nil:
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	if info, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) || (err == nil && info.IsDir()) {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	c.File(path)
+}
+
+// HLSPlaylist 校验签名后返回 HLS 播放列表，其中的子播放列表及分片替换为可访问的链接
+func HLSPlaylist(ctx context.Context, c *app.RequestContext) {
+	bucket := c.Param("bucket")
+	object := strings.TrimPrefix(c.Param("object"), "/")
+	playlist, err := minio.ServeHLSPlaylist(bucket, object, c.Query("expires"), c.Query("signature"))
+	switch {
+	case errors.Is(err, minio.ErrNotPlaylist):
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	case errors.Is(err, minio.ErrInvalidSignature), errors.Is(err, minio.ErrSignatureExpired):
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	case err != nil:
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	// 分片链接有过期时间，播放列表不应被缓存
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/vnd.apple.mpegurl", playlist)
+}
@@ -4,6 +4,7 @@ package handler
 import (
 	"context"
 	"github.com/cloudwego/hertz/pkg/app"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -13,6 +14,15 @@ import (
 	kitex "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
 )
 
+// clientInfo 客户端信息，用于记录登录会话
+func clientInfo(c *app.RequestContext) *kitex.ClientInfo {
+	return &kitex.ClientInfo{
+		Device:    c.Query("device"),
+		Ip:        c.ClientIP(),
+		UserAgent: string(c.UserAgent()),
+	}
+}
+
 // Register 注册
 func Register(ctx context.Context, c *app.RequestContext) {
 	username := c.Query("username")
@@ -40,6 +50,7 @@ func Register(ctx context.Context, c *app.RequestContext) {
 	req := &kitex.UserRegisterRequest{
 		Username: username,
 		Password: password,
+		Client:   clientInfo(c),
 	}
 	res, _ := rpc.Register(ctx, req)
 	if res.StatusCode == -1 {
@@ -56,8 +67,9 @@ func Register(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  res.StatusMsg,
 		},
-		UserID: res.UserId,
-		Token:  res.Token,
+		UserID:       res.UserId,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
 	})
 }
 
@@ -79,14 +91,19 @@ func Login(ctx context.Context, c *app.RequestContext) {
 	req := &user.UserLoginRequest{
 		Username: username,
 		Password: password,
+		Client:   clientInfo(c),
 	}
 	res, _ := rpc.Login(ctx, req)
-	if res.StatusCode == -1 {
+	if res.StatusCode != 0 {
+		if res.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.FormatInt(res.RetryAfter, 10))
+		}
 		c.JSON(http.StatusOK, response.Login{
 			Base: response.Base{
-				StatusCode: -1,
+				StatusCode: int(res.StatusCode),
 				StatusMsg:  res.StatusMsg,
 			},
+			RetryAfter: res.RetryAfter,
 		})
 		return
 	}
@@ -95,8 +112,11 @@ func Login(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  res.StatusMsg,
 		},
-		UserID: res.UserId,
-		Token:  res.Token,
+		UserID:         res.UserId,
+		Token:          res.Token,
+		RefreshToken:   res.RefreshToken,
+		MfaRequired:    res.MfaRequired,
+		ChallengeToken: res.ChallengeToken,
 	})
 }
 
@@ -150,3 +170,342 @@ func UserInfo(ctx context.Context, c *app.RequestContext) {
 		User: res.User,
 	})
 }
+
+// readFormFile 读取 multipart 表单中的文件
+func readFormFile(c *app.RequestContext, name string) ([]byte, error) {
+	file, err := c.FormFile(name)
+	if err != nil {
+		return nil, err
+	}
+	src, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	return io.ReadAll(src)
+}
+
+// UpdateAvatar 更新头像
+func UpdateAvatar(ctx context.Context, c *app.RequestContext) {
+	data, err := readFormFile(c, "data")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.UpdateAvatar{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "头像上传失败",
+			},
+		})
+		return
+	}
+
+	req := &kitex.UpdateAvatarRequest{
+		Token: c.PostForm("token"),
+		Data:  data,
+	}
+	res, _ := rpc.UpdateAvatar(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.UpdateAvatar{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UpdateAvatar{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Avatar: res.Avatar,
+	})
+}
+
+// UpdateBackground 更新个人页顶部大图
+func UpdateBackground(ctx context.Context, c *app.RequestContext) {
+	data, err := readFormFile(c, "data")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.UpdateBackground{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "背景图上传失败",
+			},
+		})
+		return
+	}
+
+	req := &kitex.UpdateBackgroundRequest{
+		Token: c.PostForm("token"),
+		Data:  data,
+	}
+	res, _ := rpc.UpdateBackground(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.UpdateBackground{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UpdateBackground{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		BackgroundImage: res.BackgroundImage,
+	})
+}
+
+// UpdateProfile 修改用户名及个人简介
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	signature, setSignature := c.GetQuery("signature")
+	req := &kitex.UpdateProfileRequest{
+		Token:        c.Query("token"),
+		Name:         c.Query("name"),
+		Signature:    signature,
+		SetSignature: setSignature,
+	}
+	res, _ := rpc.UpdateProfile(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.UpdateProfile{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UpdateProfile{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Name:      res.Name,
+		Signature: res.Signature,
+	})
+}
+
+// RefreshToken 使用刷新令牌换取新的访问令牌与刷新令牌
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	refreshToken := c.Query("refresh_token")
+	if len(refreshToken) == 0 {
+		c.JSON(http.StatusOK, response.RefreshToken{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "refresh_token 不能为空",
+			},
+		})
+		return
+	}
+
+	req := &kitex.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+		Client:       clientInfo(c),
+	}
+	res, _ := rpc.RefreshToken(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.RefreshToken{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.RefreshToken{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		UserID:       res.UserId,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	})
+}
+
+// Logout 登出当前设备，吊销当前会话及一并提交的刷新令牌
+func Logout(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.LogoutRequest{
+		Token:        c.Query("token"),
+		RefreshToken: c.Query("refresh_token"),
+	}
+	res, _ := rpc.Logout(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.Logout{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.Logout{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+// ListSessions 获取当前用户已登录的设备
+func ListSessions(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.ListSessionsRequest{
+		Token: c.Query("token"),
+	}
+	res, _ := rpc.ListSessions(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.ListSessions{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.ListSessions{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Sessions: res.Sessions,
+	})
+}
+
+// RevokeSession 登出指定设备，该设备的令牌立即失效
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.RevokeSessionRequest{
+		Token:     c.Query("token"),
+		SessionId: c.Query("session_id"),
+	}
+	res, _ := rpc.RevokeSession(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.RevokeSession{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.RevokeSession{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+// EnrollTOTP 绑定验证器，返回密钥及恢复码，需调用 ConfirmTOTP 提交验证码后才开启两步验证
+func EnrollTOTP(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.EnrollTOTPRequest{
+		Token: c.Query("token"),
+	}
+	res, _ := rpc.EnrollTOTP(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.EnrollTOTP{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.EnrollTOTP{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		OtpauthURI:    res.OtpauthUri,
+		Secret:        res.Secret,
+		RecoveryCodes: res.RecoveryCodes,
+	})
+}
+
+// ConfirmTOTP 提交验证器生成的验证码，确认绑定并开启两步验证
+func ConfirmTOTP(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.ConfirmTOTPRequest{
+		Token: c.Query("token"),
+		Code:  c.Query("code"),
+	}
+	res, _ := rpc.ConfirmTOTP(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.ConfirmTOTP{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.ConfirmTOTP{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+// VerifyTOTPLogin 使用登录时返回的挑战令牌及验证码或恢复码完成登录
+func VerifyTOTPLogin(ctx context.Context, c *app.RequestContext) {
+	challengeToken := c.Query("challenge_token")
+	code := c.Query("code")
+	if len(challengeToken) == 0 || len(code) == 0 {
+		c.JSON(http.StatusBadRequest, response.VerifyTOTPLogin{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "challenge_token 或验证码不能为空",
+			},
+		})
+		return
+	}
+
+	req := &kitex.VerifyTOTPLoginRequest{
+		ChallengeToken: challengeToken,
+		Code:           code,
+		Client:         clientInfo(c),
+	}
+	res, _ := rpc.VerifyTOTPLogin(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.VerifyTOTPLogin{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.VerifyTOTPLogin{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		UserID:       res.UserId,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	})
+}
+
+// DisableTOTP 使用验证码或恢复码关闭两步验证
+func DisableTOTP(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.DisableTOTPRequest{
+		Token: c.Query("token"),
+		Code:  c.Query("code"),
+	}
+	res, _ := rpc.DisableTOTP(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.DisableTOTP{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.DisableTOTP{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
@@ -25,9 +25,15 @@ func Feed(ctx context.Context, c *app.RequestContext) {
 		timestamp = time.Now().UnixMilli()
 	}
 
+	feedMode, _ := strconv.ParseInt(c.Query("feed_mode"), 10, 32)
+	feedType, _ := strconv.ParseInt(c.Query("feed_type"), 10, 32)
+
 	req := &kitex.FeedRequest{
 		LatestTime: timestamp,
 		Token:      token,
+		FeedMode:   int32(feedMode),
+		FeedType:   int32(feedType),
+		Cursor:     c.Query("cursor"),
 	}
 	res, _ := rpc.Feed(ctx, req)
 	if res.StatusCode == -1 {
@@ -44,12 +50,14 @@ func Feed(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  res.StatusMsg,
 		},
-		VideoList: res.VideoList,
+		NextTime:   res.NextTime,
+		NextCursor: res.NextCursor,
+		VideoList:  res.VideoList,
 	})
 }
 
 func PublishList(ctx context.Context, c *app.RequestContext) {
-	token := c.GetString("token")
+	token := c.Query("token")
 
 	uid, err := strconv.ParseInt(c.Query("user_id"), 10, 64)
 	if err != nil {
@@ -64,6 +72,7 @@ func PublishList(ctx context.Context, c *app.RequestContext) {
 	req := &kitex.PublishListRequest{
 		Token:  token,
 		UserId: uid,
+		Cursor: c.Query("cursor"),
 	}
 	res, _ := rpc.PublishList(ctx, req)
 	if res.StatusCode == -1 {
@@ -80,7 +89,8 @@ func PublishList(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		VideoList: res.VideoList,
+		VideoList:  res.VideoList,
+		NextCursor: res.NextCursor,
 	})
 }
 
@@ -106,6 +116,26 @@ func PublishAction(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	visibility, ok := parseVisibility(c.PostForm("visibility"))
+	if !ok {
+		c.JSON(http.StatusOK, response.PublishAction{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "visibility 不合法",
+			},
+		})
+		return
+	}
+	draft, publishAt, ok := parseSchedule(c.PostForm("draft"), c.PostForm("publish_at"))
+	if !ok {
+		c.JSON(http.StatusOK, response.PublishAction{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "draft 或 publish_at 不合法",
+			},
+		})
+		return
+	}
 	// 视频数据
 	file, err := c.FormFile("data")
 	if err != nil {
@@ -131,16 +161,40 @@ func PublishAction(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 自定义封面，可选
+	coverBuf := bytes.NewBuffer(nil)
+	if coverFile, err := c.FormFile("cover"); err == nil {
+		coverSrc, err := coverFile.Open()
+		if err == nil {
+			_, err = io.Copy(coverBuf, coverSrc)
+			coverSrc.Close()
+		}
+		if err != nil {
+			logger.Errorln(err.Error())
+			c.JSON(http.StatusBadRequest, response.PublishAction{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "封面上传失败",
+				},
+			})
+			return
+		}
+	}
+
 	req := &kitex.PublishActionRequest{
-		Token: token,
-		Title: title,
-		Data:  buf.Bytes(),
+		Token:      token,
+		Title:      title,
+		Data:       buf.Bytes(),
+		Cover:      coverBuf.Bytes(),
+		Visibility: visibility,
+		Draft:      draft,
+		PublishAt:  publishAt,
 	}
 	res, _ := rpc.PublishAction(ctx, req)
-	if res.StatusCode == -1 {
+	if res.StatusCode != 0 {
 		c.JSON(http.StatusOK, response.PublishAction{
 			Base: response.Base{
-				StatusCode: -1,
+				StatusCode: int(res.StatusCode),
 				StatusMsg:  res.StatusMsg,
 			},
 		})
@@ -151,5 +205,658 @@ func PublishAction(ctx context.Context, c *app.RequestContext) {
 			StatusCode: 0,
 			StatusMsg:  res.StatusMsg,
 		},
+		VideoID: res.VideoId,
+	})
+}
+
+func UploadInit(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+	title := c.Query("title")
+	uploadID := c.Query("upload_id")
+	visibility, ok := parseVisibility(c.Query("visibility"))
+	if !ok {
+		c.JSON(http.StatusOK, response.UploadInit{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "visibility 不合法",
+			},
+		})
+		return
+	}
+	draft, publishAt, ok := parseSchedule(c.Query("draft"), c.Query("publish_at"))
+	if !ok {
+		c.JSON(http.StatusOK, response.UploadInit{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "draft 或 publish_at 不合法",
+			},
+		})
+		return
+	}
+	var fileSize int64
+	if uploadID == "" {
+		// 新建上传会话时需提供标题和文件大小，续传时只需 upload_id
+		size, err := strconv.ParseInt(c.Query("file_size"), 10, 64)
+		if err != nil || size <= 0 {
+			c.JSON(http.StatusOK, response.UploadInit{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "file_size 不合法",
+				},
+			})
+			return
+		}
+		if title == "" {
+			c.JSON(http.StatusOK, response.UploadInit{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "标题不能为空",
+				},
+			})
+			return
+		}
+		fileSize = size
+	}
+
+	req := &kitex.UploadInitRequest{
+		Token:      token,
+		Title:      title,
+		FileSize:   fileSize,
+		UploadId:   uploadID,
+		Visibility: visibility,
+		Draft:      draft,
+		PublishAt:  publishAt,
+		FileName:   c.Query("file_name"),
+	}
+	res, _ := rpc.UploadInit(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.UploadInit{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UploadInit{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		UploadID:      res.UploadId,
+		PartSize:      res.PartSize,
+		PartCount:     res.PartCount,
+		UploadedParts: res.UploadedParts,
+	})
+}
+
+func UploadPart(ctx context.Context, c *app.RequestContext) {
+	logger := zap.InitLogger()
+	token := c.PostForm("token")
+	uploadID := c.PostForm("upload_id")
+	if uploadID == "" {
+		c.JSON(http.StatusOK, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "upload_id 不能为空",
+			},
+		})
+		return
+	}
+	partNumber, err := strconv.ParseInt(c.PostForm("part_number"), 10, 32)
+	if err != nil || partNumber < 1 {
+		c.JSON(http.StatusOK, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "part_number 不合法",
+			},
+		})
+		return
+	}
+	// 分片数据
+	file, err := c.FormFile("data")
+	if err != nil {
+		logger.Errorln(err.Error())
+		c.JSON(http.StatusBadRequest, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "上传分片加载失败",
+			},
+		})
+		return
+	}
+	src, err := file.Open()
+	if err != nil {
+		logger.Errorln(err.Error())
+		c.JSON(http.StatusBadRequest, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "上传分片加载失败",
+			},
+		})
+		return
+	}
+	defer src.Close()
+	buf := bytes.NewBuffer(make([]byte, 0, file.Size))
+	if _, err := io.Copy(buf, src); err != nil {
+		logger.Errorln(err.Error())
+		c.JSON(http.StatusBadRequest, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "分片上传失败",
+			},
+		})
+		return
+	}
+
+	req := &kitex.UploadPartRequest{
+		Token:      token,
+		UploadId:   uploadID,
+		PartNumber: int32(partNumber),
+		Data:       buf.Bytes(),
+	}
+	res, _ := rpc.UploadPart(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.UploadPart{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UploadPart{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		PartNumber: res.PartNumber,
+	})
+}
+
+func UploadComplete(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+	uploadID := c.Query("upload_id")
+	if uploadID == "" {
+		c.JSON(http.StatusOK, response.UploadComplete{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "upload_id 不能为空",
+			},
+		})
+		return
+	}
+
+	// 自定义封面，可选
+	coverBuf := bytes.NewBuffer(nil)
+	if coverFile, err := c.FormFile("cover"); err == nil {
+		coverSrc, err := coverFile.Open()
+		if err == nil {
+			_, err = io.Copy(coverBuf, coverSrc)
+			coverSrc.Close()
+		}
+		if err != nil {
+			zap.InitLogger().Errorln(err.Error())
+			c.JSON(http.StatusBadRequest, response.UploadComplete{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "封面上传失败",
+				},
+			})
+			return
+		}
+	}
+
+	req := &kitex.UploadCompleteRequest{
+		Token:    token,
+		UploadId: uploadID,
+		Cover:    coverBuf.Bytes(),
+	}
+	res, _ := rpc.UploadComplete(ctx, req)
+	if res.StatusCode != 0 {
+		c.JSON(http.StatusOK, response.UploadComplete{
+			Base: response.Base{
+				StatusCode: int(res.StatusCode),
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UploadComplete{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		VideoID: res.VideoId,
+	})
+}
+
+func UploadAbort(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+	uploadID := c.Query("upload_id")
+	if uploadID == "" {
+		c.JSON(http.StatusOK, response.UploadAbort{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "upload_id 不能为空",
+			},
+		})
+		return
+	}
+
+	req := &kitex.UploadAbortRequest{
+		Token:    token,
+		UploadId: uploadID,
+	}
+	res, _ := rpc.UploadAbort(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.UploadAbort{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UploadAbort{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+func PublishStatus(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.PublishStatus{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	req := &kitex.PublishStatusRequest{
+		Token:   token,
+		VideoId: vid,
+	}
+	res, _ := rpc.PublishStatus(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.PublishStatus{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.PublishStatus{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		VideoID:       res.VideoId,
+		ProcessStatus: res.ProcessStatus,
+		Retries:       res.Retries,
+		FailReason:    res.FailReason,
+	})
+}
+
+func DeleteVideo(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.DeleteVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	req := &kitex.DeleteVideoRequest{
+		Token:   token,
+		VideoId: vid,
+	}
+	res, _ := rpc.DeleteVideo(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.DeleteVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.DeleteVideo{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+func UpdateVideo(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.UpdateVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	visibility, ok := parseVisibility(c.Query("visibility"))
+	if !ok {
+		c.JSON(http.StatusOK, response.UpdateVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "visibility 不合法",
+			},
+		})
+		return
+	}
+	req := &kitex.UpdateVideoRequest{
+		Token:      token,
+		VideoId:    vid,
+		Title:      c.Query("title"),
+		Visibility: visibility,
+	}
+	res, _ := rpc.UpdateVideo(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.UpdateVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.UpdateVideo{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+func PlayAction(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.PlayAction{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	var watchDuration float64
+	if d := c.Query("watch_duration"); d != "" {
+		if watchDuration, err = strconv.ParseFloat(d, 64); err != nil {
+			c.JSON(http.StatusOK, response.PlayAction{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "watch_duration 不合法",
+				},
+			})
+			return
+		}
+	}
+	req := &kitex.PlayActionRequest{
+		Token:         token,
+		VideoId:       vid,
+		WatchDuration: watchDuration,
+	}
+	res, _ := rpc.PlayAction(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.PlayAction{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.PlayAction{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+	})
+}
+
+func TopicFeed(ctx context.Context, c *app.RequestContext) {
+	tag := c.Query("tag")
+	if tag == "" {
+		c.JSON(http.StatusOK, response.TopicFeed{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "tag 不能为空",
+			},
+		})
+		return
+	}
+	req := &kitex.TopicFeedRequest{
+		Token:  c.Query("token"),
+		Tag:    tag,
+		Cursor: c.Query("cursor"),
+	}
+	res, _ := rpc.TopicFeed(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.TopicFeed{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.TopicFeed{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Tag:        res.Tag,
+		VideoList:  res.VideoList,
+		NextCursor: res.NextCursor,
+	})
+}
+
+func TrendingTags(ctx context.Context, c *app.RequestContext) {
+	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 32)
+
+	req := &kitex.TrendingTagsRequest{
+		Limit: int32(limit),
+	}
+	res, _ := rpc.TrendingTags(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.TrendingTags{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.TrendingTags{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Tags: res.Tags,
+	})
+}
+
+func ShareVideo(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.ShareVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	req := &kitex.ShareVideoRequest{
+		Token:   token,
+		VideoId: vid,
+		Channel: c.Query("channel"),
+	}
+	res, _ := rpc.ShareVideo(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.ShareVideo{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.ShareVideo{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		ShareCode:  res.ShareCode,
+		ShareUrl:   res.ShareUrl,
+		ShareCount: res.ShareCount,
+	})
+}
+
+func ResolveShare(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.ResolveShareRequest{
+		ShareCode: c.Param("code"),
+		Token:     c.Query("token"),
+	}
+	res, _ := rpc.ResolveShare(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.ResolveShare{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.ResolveShare{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		Video:    res.Video,
+		SharerId: res.SharerId,
+	})
+}
+
+// parseVisibility 解析可选的可见范围参数，为空时返回 0
+func parseVisibility(s string) (int32, bool) {
+	if s == "" {
+		return 0, true
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err == nil
+}
+
+// parseSchedule 解析可选的草稿及定时发布参数，为空时分别返回 false 和 0
+func parseSchedule(draft string, publishAt string) (bool, int64, bool) {
+	var isDraft bool
+	var at int64
+	var err error
+	if draft != "" {
+		if isDraft, err = strconv.ParseBool(draft); err != nil {
+			return false, 0, false
+		}
+	}
+	if publishAt != "" {
+		if at, err = strconv.ParseInt(publishAt, 10, 64); err != nil {
+			return false, 0, false
+		}
+	}
+	return isDraft, at, true
+}
+
+func DraftList(ctx context.Context, c *app.RequestContext) {
+	req := &kitex.DraftListRequest{
+		Token:  c.Query("token"),
+		Cursor: c.Query("cursor"),
+	}
+	res, _ := rpc.DraftList(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.DraftList{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.DraftList{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
+		VideoList:  res.VideoList,
+		NextCursor: res.NextCursor,
+	})
+}
+
+func PublishDraft(ctx context.Context, c *app.RequestContext) {
+	token := c.Query("token")
+
+	vid, err := strconv.ParseInt(c.Query("video_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusOK, response.PublishDraft{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  "video_id 不合法",
+			},
+		})
+		return
+	}
+	var publishAt int64
+	if t := c.Query("publish_at"); t != "" {
+		if publishAt, err = strconv.ParseInt(t, 10, 64); err != nil {
+			c.JSON(http.StatusOK, response.PublishDraft{
+				Base: response.Base{
+					StatusCode: -1,
+					StatusMsg:  "publish_at 不合法",
+				},
+			})
+			return
+		}
+	}
+	req := &kitex.PublishDraftRequest{
+		Token:     token,
+		VideoId:   vid,
+		PublishAt: publishAt,
+	}
+	res, _ := rpc.PublishDraft(ctx, req)
+	if res.StatusCode == -1 {
+		c.JSON(http.StatusOK, response.PublishDraft{
+			Base: response.Base{
+				StatusCode: -1,
+				StatusMsg:  res.StatusMsg,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, response.PublishDraft{
+		Base: response.Base{
+			StatusCode: 0,
+			StatusMsg:  res.StatusMsg,
+		},
 	})
 }
@@ -19,11 +19,35 @@ var (
 	apiServerName = apiConfig.Viper.GetString("server.name")
 	apiServerAddr = fmt.Sprintf("%s:%d", apiConfig.Viper.GetString("server.host"), apiConfig.Viper.GetInt("server.port"))
 	etcdAddress   = fmt.Sprintf("%s:%d", apiConfig.Viper.GetString("Etcd.host"), apiConfig.Viper.GetInt("Etcd.port"))
-	signingKey    = apiConfig.Viper.GetString("JWT.signingKey")
 	serverTLSKey  = apiConfig.Viper.GetString("Hertz.tls.keyFile")
 	serverTLSCert = apiConfig.Viper.GetString("Hertz.tls.certFile")
+	keyConfig     = jwt.LoadKeyConfig(apiConfig)
+	videoConfig   = viper.Init("video")
+	userConfig    = viper.Init("user")
 )
 
+// formOverhead multipart 表单中文件以外的字段、边界及各部分头部的开销
+const formOverhead = 1 << 20
+
+// maxRequestBodySize 请求体大小上限，取单次发布的视频及封面、分片上传的一个完整分片、头像、背景图中最大者，
+// 另加 multipart 表单的开销。Hertz 默认只允许 4MiB，超出的请求在进入处理函数前即被拒绝
+func maxRequestBodySize() int {
+	const mb, mib = 1000 * 1000, 1 << 20
+	sizes := []int{
+		(videoConfig.Viper.GetInt("video.maxSizeLimit") + videoConfig.Viper.GetInt("video.cover.maxSizeLimit")) * mb,
+		videoConfig.Viper.GetInt("video.upload.partSize") * mib,
+		userConfig.Viper.GetInt("user.avatar.maxSizeLimit") * mb,
+		userConfig.Viper.GetInt("user.background.maxSizeLimit") * mb,
+	}
+	limit := 0
+	for _, size := range sizes {
+		if size > limit {
+			limit = size
+		}
+	}
+	return limit + formOverhead
+}
+
 func registerGroup(hz *server.Hertz) {
 	douyin := hz.Group("/douyin")
 	{
@@ -32,6 +56,19 @@ func registerGroup(hz *server.Hertz) {
 			user.GET("/", handler.UserInfo)
 			user.POST("/register/", handler.Register)
 			user.POST("/login/", handler.Login)
+			user.POST("/update/", handler.UpdateProfile)
+			user.POST("/refresh/", handler.RefreshToken)
+			user.POST("/logout/", handler.Logout)
+			user.GET("/session/", handler.ListSessions)
+			user.POST("/session/revoke/", handler.RevokeSession)
+			// 两步验证
+			user.POST("/totp/enroll/", handler.EnrollTOTP)
+			user.POST("/totp/confirm/", handler.ConfirmTOTP)
+			user.POST("/totp/verify/", handler.VerifyTOTPLogin)
+			user.POST("/totp/disable/", handler.DisableTOTP)
+			// 头像与背景图，multipart 表单上传
+			user.POST("/avatar/", handler.UpdateAvatar)
+			user.POST("/background/", handler.UpdateBackground)
 		}
 		message := douyin.Group("/message")
 		{
@@ -52,25 +89,60 @@ func registerGroup(hz *server.Hertz) {
 		{
 			publish.GET("/list/", handler.PublishList)
 			publish.POST("/action/", handler.PublishAction)
+			publish.GET("/status/", handler.PublishStatus)
+			publish.POST("/delete/", handler.DeleteVideo)
+			publish.POST("/update/", handler.UpdateVideo)
+			// 草稿与定时发布
+			publish.GET("/draft/list/", handler.DraftList)
+			publish.POST("/draft/publish/", handler.PublishDraft)
+			// 分片上传
+			publish.POST("/upload/init/", handler.UploadInit)
+			publish.POST("/upload/part/", handler.UploadPart)
+			publish.POST("/upload/complete/", handler.UploadComplete)
+			publish.POST("/upload/abort/", handler.UploadAbort)
 		}
 		douyin.GET("/feed", handler.Feed)
+		douyin.POST("/play/action/", handler.PlayAction)
+		topic := douyin.Group("/topic")
+		{
+			topic.GET("/feed/", handler.TopicFeed)
+			topic.GET("/trending/", handler.TrendingTags)
+		}
+		share := douyin.Group("/share")
+		{
+			share.POST("/action/", handler.ShareVideo)
+			// 分享链接，无需登录
+			share.GET("/:code", handler.ResolveShare)
+		}
 		favorite := douyin.Group("/favorite")
 		{
 			favorite.POST("/action/", handler.FavoriteAction)
 			favorite.GET("/list/", handler.FavoriteList)
 		}
+		search := douyin.Group("/search")
+		{
+			search.GET("/user/", handler.SearchUser)
+			search.GET("/video/", handler.SearchVideo)
+		}
 		comment := douyin.Group("/comment")
 		{
 			comment.POST("/action/", handler.CommentAction)
 			comment.GET("/list/", handler.CommentList)
 		}
 	}
+	// 本地存储的对象访问，仅在存储后端为 local 时可用
+	hz.GET("/storage/:bucket/*object", handler.LocalStorage)
+	// HLS 播放列表，校验签名后改写其中的子播放列表及分片链接
+	hz.GET("/hls/:bucket/*object", handler.HLSPlaylist)
 }
 
 func InitHertz() *server.Hertz {
 	logger := z.InitLogger()
 
-	opts := []config.Option{server.WithHostPorts(apiServerAddr)}
+	opts := []config.Option{
+		server.WithHostPorts(apiServerAddr),
+		server.WithMaxRequestBodySize(maxRequestBodySize()),
+	}
 
 	// 网络库
 	hertzNet := standard.NewTransporter
@@ -114,20 +186,41 @@ func InitHertz() *server.Hertz {
 
 	hz := server.Default(opts...)
 
+	// Hertz 默认的 ClientIP 信任任意客户端的 X-Forwarded-For，只信任配置的反向代理
+	clientIP, err := middleware.ClientIPFunc(apiConfig.Viper.GetStringSlice("Hertz.trustedProxies"))
+	if err != nil {
+		panic(err)
+	}
+	hz.SetClientIPFunc(clientIP)
+
+	// 鉴权时检查令牌是否已被吊销，登出或被盗用的令牌立即失效
+	authJwt := jwt.MustNewJWTWithKeys(keyConfig)
+	authJwt.Revoker = jwt.NewRedisRevoker()
+
 	hz.Use(
 		// secure.New(
 		// 	secure.WithSSLHost(apiServerAddr),
 		// 	secure.WithSSLRedirect(true),
 		// ),	// TLS
-		middleware.TokenAuthMiddleware(*jwt.NewJWT([]byte(signingKey)),
+		middleware.TokenAuthMiddleware(*authJwt,
 			"/douyin/user/register/",
 			"/douyin/user/login/",
+			"/douyin/user/refresh/",
+			"/douyin/user/totp/verify/",
 			"/douyin/feed",
 			"/douyin/favorite/list/",
 			"/douyin/publish/list/",
 			"/douyin/comment/list/",
 			"/douyin/relation/follower/list/",
 			"/douyin/relation/follow/list/",
+			"/douyin/play/action/",
+			"/douyin/topic/feed/",
+			"/douyin/topic/trending/",
+			"/douyin/share/:code",
+			"/douyin/search/user/",
+			"/douyin/search/video/",
+			"/storage/:bucket/*object",
+			"/hls/:bucket/*object",
 		), // 用户鉴权中间件
 		middleware.TokenLimitMiddleware(), //限流中间件
 		middleware.AccessLog(),
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+// uploadPart 以 multipart 表单上传一个大小为 size 的分片，返回响应状态码及响应体
+func uploadPart(t *testing.T, url string, size int) (int, string) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	_ = w.WriteField("token", "token")
+	_ = w.WriteField("upload_id", "upload")
+	_ = w.WriteField("part_number", "1")
+	part, err := w.CreateFormFile("data", "part")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := part.Write(bytes.Repeat([]byte{0x5a}, size)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Post(url, w.FormDataContentType(), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(data)
+}
+
+func TestUploadFullPart(t *testing.T) {
+	addr := "127.0.0.1:18089"
+	hz := server.New(
+		server.WithHostPorts(addr),
+		server.WithMaxRequestBodySize(maxRequestBodySize()),
+		server.WithExitWaitTime(time.Second),
+	)
+	// 与网关相同的路由，处理函数只返回收到的分片大小，不调用视频服务
+	hz.POST("/douyin/publish/upload/part/", func(ctx context.Context, c *app.RequestContext) {
+		file, err := c.FormFile("data")
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		c.String(http.StatusOK, strconv.FormatInt(file.Size, 10))
+	})
+	go hz.Run()
+	defer hz.Shutdown(context.Background())
+	for i := 0; i < 50; i++ {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	url := "http://" + addr + "/douyin/publish/upload/part/"
+	partSize := videoConfig.Viper.GetInt("video.upload.partSize") << 20
+	if code, body := uploadPart(t, url, partSize); code != http.StatusOK || body != strconv.Itoa(partSize) {
+		t.Fatalf("upload full part = %d %s, want %d %d", code, body, http.StatusOK, partSize)
+	}
+
+	// 超出上限的请求仍被拒绝
+	if code, _ := uploadPart(t, url, maxRequestBodySize()); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("upload oversized part = %d, want %d", code, http.StatusRequestEntityTooLarge)
+	}
+}
@@ -15,6 +15,10 @@ func init() {
 	messageConfig := viper.Init("message")
 	InitMessage(&messageConfig)
 
+	// search rpc
+	searchConfig := viper.Init("search")
+	InitSearch(&searchConfig)
+
 	// relation rpc
 	relationConfig := viper.Init("relation")
 	InitRelation(&relationConfig)
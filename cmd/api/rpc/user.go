@@ -57,3 +57,47 @@ func Login(ctx context.Context, req *user.UserLoginRequest) (*user.UserLoginResp
 func UserInfo(ctx context.Context, req *user.UserInfoRequest) (*user.UserInfoResponse, error) {
 	return userClient.UserInfo(ctx, req)
 }
+
+func UpdateAvatar(ctx context.Context, req *user.UpdateAvatarRequest) (*user.UpdateAvatarResponse, error) {
+	return userClient.UpdateAvatar(ctx, req)
+}
+
+func UpdateBackground(ctx context.Context, req *user.UpdateBackgroundRequest) (*user.UpdateBackgroundResponse, error) {
+	return userClient.UpdateBackground(ctx, req)
+}
+
+func UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.UpdateProfileResponse, error) {
+	return userClient.UpdateProfile(ctx, req)
+}
+
+func RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error) {
+	return userClient.RefreshToken(ctx, req)
+}
+
+func Logout(ctx context.Context, req *user.LogoutRequest) (*user.LogoutResponse, error) {
+	return userClient.Logout(ctx, req)
+}
+
+func ListSessions(ctx context.Context, req *user.ListSessionsRequest) (*user.ListSessionsResponse, error) {
+	return userClient.ListSessions(ctx, req)
+}
+
+func RevokeSession(ctx context.Context, req *user.RevokeSessionRequest) (*user.RevokeSessionResponse, error) {
+	return userClient.RevokeSession(ctx, req)
+}
+
+func EnrollTOTP(ctx context.Context, req *user.EnrollTOTPRequest) (*user.EnrollTOTPResponse, error) {
+	return userClient.EnrollTOTP(ctx, req)
+}
+
+func ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPRequest) (*user.ConfirmTOTPResponse, error) {
+	return userClient.ConfirmTOTP(ctx, req)
+}
+
+func VerifyTOTPLogin(ctx context.Context, req *user.VerifyTOTPLoginRequest) (*user.VerifyTOTPLoginResponse, error) {
+	return userClient.VerifyTOTPLogin(ctx, req)
+}
+
+func DisableTOTP(ctx context.Context, req *user.DisableTOTPRequest) (*user.DisableTOTPResponse, error) {
+	return userClient.DisableTOTP(ctx, req)
+}
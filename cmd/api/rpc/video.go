@@ -58,3 +58,59 @@ func PublishAction(ctx context.Context, req *video.PublishActionRequest) (*video
 func PublishList(ctx context.Context, req *video.PublishListRequest) (*video.PublishListResponse, error) {
 	return videoClient.PublishList(ctx, req)
 }
+
+func UploadInit(ctx context.Context, req *video.UploadInitRequest) (*video.UploadInitResponse, error) {
+	return videoClient.UploadInit(ctx, req)
+}
+
+func UploadPart(ctx context.Context, req *video.UploadPartRequest) (*video.UploadPartResponse, error) {
+	return videoClient.UploadPart(ctx, req)
+}
+
+func UploadComplete(ctx context.Context, req *video.UploadCompleteRequest) (*video.UploadCompleteResponse, error) {
+	return videoClient.UploadComplete(ctx, req)
+}
+
+func UploadAbort(ctx context.Context, req *video.UploadAbortRequest) (*video.UploadAbortResponse, error) {
+	return videoClient.UploadAbort(ctx, req)
+}
+
+func PublishStatus(ctx context.Context, req *video.PublishStatusRequest) (*video.PublishStatusResponse, error) {
+	return videoClient.PublishStatus(ctx, req)
+}
+
+func DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest) (*video.DeleteVideoResponse, error) {
+	return videoClient.DeleteVideo(ctx, req)
+}
+
+func UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest) (*video.UpdateVideoResponse, error) {
+	return videoClient.UpdateVideo(ctx, req)
+}
+
+func PlayAction(ctx context.Context, req *video.PlayActionRequest) (*video.PlayActionResponse, error) {
+	return videoClient.PlayAction(ctx, req)
+}
+
+func TopicFeed(ctx context.Context, req *video.TopicFeedRequest) (*video.TopicFeedResponse, error) {
+	return videoClient.TopicFeed(ctx, req)
+}
+
+func TrendingTags(ctx context.Context, req *video.TrendingTagsRequest) (*video.TrendingTagsResponse, error) {
+	return videoClient.TrendingTags(ctx, req)
+}
+
+func ShareVideo(ctx context.Context, req *video.ShareVideoRequest) (*video.ShareVideoResponse, error) {
+	return videoClient.ShareVideo(ctx, req)
+}
+
+func ResolveShare(ctx context.Context, req *video.ResolveShareRequest) (*video.ResolveShareResponse, error) {
+	return videoClient.ResolveShare(ctx, req)
+}
+
+func DraftList(ctx context.Context, req *video.DraftListRequest) (*video.DraftListResponse, error) {
+	return videoClient.DraftList(ctx, req)
+}
+
+func PublishDraft(ctx context.Context, req *video.PublishDraftRequest) (*video.PublishDraftResponse, error) {
+	return videoClient.PublishDraft(ctx, req)
+}
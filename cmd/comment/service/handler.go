@@ -39,12 +39,31 @@ func (s *CommentServiceImpl) CommentAction(ctx context.Context, req *comment.Com
 		return res, nil
 	}
 	if actionType == 1 {
+		// 仅能评论自己可以查看的视频
+		visible, err := db.CanViewVideo(ctx, v, userID)
+		if err != nil {
+			logger.Errorf("判断视频可见范围错误：%v", err.Error())
+			res := &comment.CommentActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "评论发布失败：服务器内部错误",
+			}
+			return res, nil
+		}
+		if !visible {
+			logger.Errorf("该视频对用户不可见：%d", req.VideoId)
+			res := &comment.CommentActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "该视频ID不存在",
+			}
+			return res, nil
+		}
+
 		cmt := &db.Comment{
 			VideoID: uint(req.VideoId),
 			UserID:  uint(userID),
 			Content: req.CommentText,
 		}
-		err := db.CreateComment(ctx, cmt)
+		err = db.CreateComment(ctx, cmt)
 		if err != nil {
 			logger.Errorf("新增评论失败：%v", err.Error())
 			res := &comment.CommentActionResponse{
@@ -134,6 +153,35 @@ func (s *CommentServiceImpl) CommentList(ctx context.Context, req *comment.Comme
 		userID = claims.Id
 	}
 
+	// 仅能查看可见视频的评论
+	v, err := db.GetVideoById(ctx, req.VideoId)
+	if err != nil {
+		logger.Errorf("获取视频错误：%v", err)
+		res := &comment.CommentListResponse{
+			StatusCode: -1,
+			StatusMsg:  "评论列表获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	visible := false
+	if v != nil {
+		if visible, err = db.CanViewVideo(ctx, v, userID); err != nil {
+			logger.Errorf("判断视频可见范围错误：%v", err)
+			res := &comment.CommentListResponse{
+				StatusCode: -1,
+				StatusMsg:  "评论列表获取失败：服务器内部错误",
+			}
+			return res, nil
+		}
+	}
+	if !visible {
+		res := &comment.CommentListResponse{
+			StatusCode: -1,
+			StatusMsg:  "该视频ID不存在",
+		}
+		return res, nil
+	}
+
 	// 从数据库获取评论列表
 	results, err := db.GetVideoCommentListByVideoID(ctx, req.VideoId)
 	if err != nil {
@@ -164,7 +212,7 @@ func (s *CommentServiceImpl) CommentList(ctx context.Context, req *comment.Comme
 			}
 			return res, nil
 		}
-		avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, u.Avatar)
+		avatar, err := minio.MediaURL(minio.AvatarBucketName, u.Avatar)
 		if err != nil {
 			logger.Errorf("Minio获取头像失败：%v", err.Error())
 			res := &comment.CommentListResponse{
@@ -173,7 +221,7 @@ func (s *CommentServiceImpl) CommentList(ctx context.Context, req *comment.Comme
 			}
 			return res, nil
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, u.Avatar)
+		backgroundUrl, err := minio.MediaURL(minio.BackgroundImageBucketName, u.Avatar)
 		if err != nil {
 			logger.Errorf("Minio获取背景图链接失败：%v", err.Error())
 			res := &comment.CommentListResponse{
@@ -8,6 +8,7 @@ var (
 	Jwt *jwt.JWT
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
 }
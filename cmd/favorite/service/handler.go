@@ -9,10 +9,9 @@ import (
 
 	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/redis"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/assembler"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
 	favorite "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/favorite"
-	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
-	video "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/video"
-	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/rabbitmq"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
 )
@@ -20,6 +19,9 @@ import (
 // FavoriteServiceImpl implements the last service interface defined in the IDL.
 type FavoriteServiceImpl struct{}
 
+// limit 喜欢列表每页的视频条数
+const limit = 30
+
 // FavoriteAction implements the FavoriteServiceImpl interface.
 func (s *FavoriteServiceImpl) FavoriteAction(ctx context.Context, req *favorite.FavoriteActionRequest) (resp *favorite.FavoriteActionResponse, err error) {
 	logger := zap.InitLogger()
@@ -35,6 +37,37 @@ func (s *FavoriteServiceImpl) FavoriteAction(ctx context.Context, req *favorite.
 	}
 	userID := claims.Id
 
+	// 仅能点赞自己可以查看的视频，取消点赞不受限制
+	if req.ActionType == 1 {
+		v, err := db.GetVideoById(ctx, req.VideoId)
+		if err != nil {
+			logger.Errorf("获取视频错误：%v", err.Error())
+			res := &favorite.FavoriteActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "操作失败：服务器内部错误",
+			}
+			return res, nil
+		}
+		visible := false
+		if v != nil {
+			if visible, err = db.CanViewVideo(ctx, v, userID); err != nil {
+				logger.Errorf("判断视频可见范围错误：%v", err.Error())
+				res := &favorite.FavoriteActionResponse{
+					StatusCode: -1,
+					StatusMsg:  "操作失败：服务器内部错误",
+				}
+				return res, nil
+			}
+		}
+		if !visible {
+			res := &favorite.FavoriteActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "视频不存在",
+			}
+			return res, nil
+		}
+	}
+
 	//将点赞信息存入消息队列,成功存入则表示点赞成功,后续处理由redis完成
 	fc := &redis.FavoriteCache{
 		VideoID:    uint(req.VideoId),
@@ -74,9 +107,33 @@ func (s *FavoriteServiceImpl) FavoriteAction(ctx context.Context, req *favorite.
 // FavoriteList implements the FavoriteServiceImpl interface.
 func (s *FavoriteServiceImpl) FavoriteList(ctx context.Context, req *favorite.FavoriteListRequest) (resp *favorite.FavoriteListResponse, err error) {
 	userID := req.UserId
+	var viewerID int64 = -1
+
+	// 验证token有效性，喜欢列表中仅展示对当前用户可见的视频
+	if req.Token != "" {
+		claims, err := Jwt.ParseToken(req.Token)
+		if err != nil {
+			logger.Errorf("token解析错误：%v", err.Error())
+			res := &favorite.FavoriteListResponse{
+				StatusCode: -1,
+				StatusMsg:  "token 解析错误",
+			}
+			return res, nil
+		}
+		viewerID = claims.Id
+	}
+	cur, err := cursor.Decode(req.Cursor)
+	if err != nil {
+		logger.Errorf("cursor 解析错误：%s", req.Cursor)
+		res := &favorite.FavoriteListResponse{
+			StatusCode: -1,
+			StatusMsg:  "cursor 不合法",
+		}
+		return res, nil
+	}
 
 	// 从数据库获取喜欢列表
-	results, err := db.GetFavoriteListByUserID(ctx, userID)
+	results, err := db.GetFavoriteListByUserID(ctx, userID, limit, cur)
 	if err != nil {
 		logger.Errorf("获取喜欢列表错误：%v", err.Error())
 		res := &favorite.FavoriteListResponse{
@@ -85,101 +142,52 @@ func (s *FavoriteServiceImpl) FavoriteList(ctx context.Context, req *favorite.Fa
 		}
 		return res, nil
 	}
-	favorites := make([]*video.Video, 0)
+	// 批量获取视频，并按点赞关系的顺序排列
+	videoIDs := make([]int64, 0, len(results))
 	for _, r := range results {
-		v, err := db.GetVideoById(ctx, int64(r.VideoID))
-		if err != nil {
-			logger.Errorf("获取视频错误：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
-		}
-
-		u, err := db.GetUserByID(ctx, int64(v.AuthorID))
-		if err != nil {
-			logger.Errorf("获取用户错误：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
-		}
-
-		relation, err := db.GetRelationByUserIDs(ctx, userID, int64(u.ID))
-		if err != nil {
-			logger.Errorf("发生错误：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
-		}
-		playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, v.PlayUrl)
-		if err != nil {
-			logger.Errorf("发生错误：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
-		}
-		coverUrl, err := minio.GetFileTemporaryURL(minio.CoverBucketName, v.CoverUrl)
-		if err != nil {
-			logger.Errorf("发生错误：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
+		videoIDs = append(videoIDs, int64(r.VideoID))
+	}
+	videos, err := db.GetVisibleVideoListByIDs(ctx, videoIDs, viewerID)
+	if err != nil {
+		logger.Errorf("获取视频错误：%v", err.Error())
+		res := &favorite.FavoriteListResponse{
+			StatusCode: -1,
+			StatusMsg:  "获取喜欢列表失败：服务器内部错误",
 		}
-		avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, u.Avatar)
-		if err != nil {
-			logger.Errorf("Minio获取头像失败：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
+		return res, nil
+	}
+	videoMap := make(map[uint]*db.Video, len(videos))
+	for _, v := range videos {
+		videoMap[v.ID] = v
+	}
+	ordered := make([]*db.Video, 0, len(videos))
+	for _, r := range results {
+		if v, ok := videoMap[r.VideoID]; ok {
+			ordered = append(ordered, v)
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, u.BackgroundImage)
-		if err != nil {
-			logger.Errorf("Minio获取链接失败：%v", err.Error())
-			res := &favorite.FavoriteListResponse{
-				StatusCode: -1,
-				StatusMsg:  "获取喜欢列表失败：服务器内部错误",
-			}
-			return res, nil
+	}
+	favorites, err := assembler.Videos(ctx, ordered, viewerID)
+	if err != nil {
+		logger.Errorf("组装视频错误：%v", err.Error())
+		res := &favorite.FavoriteListResponse{
+			StatusCode: -1,
+			StatusMsg:  "获取喜欢列表失败：服务器内部错误",
 		}
-		favorites = append(favorites, &video.Video{
-			Id: int64(r.VideoID),
-			Author: &user.User{
-				Id:              int64(u.ID),
-				Name:            u.UserName,
-				FollowCount:     int64(u.FollowingCount),
-				FollowerCount:   int64(u.FollowerCount),
-				IsFollow:        relation != nil,
-				Avatar:          avatar,
-				BackgroundImage: backgroundUrl,
-				Signature:       u.Signature,
-				TotalFavorited:  int64(u.TotalFavorited),
-				WorkCount:       int64(u.WorkCount),
-				FavoriteCount:   int64(u.FavoriteCount),
-			},
-			PlayUrl:       playUrl,
-			CoverUrl:      coverUrl,
-			FavoriteCount: int64(v.FavoriteCount),
-			CommentCount:  int64(v.CommentCount),
-			IsFavorite:    true,
-			Title:         v.Title,
-		})
+		return res, nil
+	}
+
+	// 以点赞关系的位置作为游标，已删除或不可见的视频不影响分页
+	var nextCursor *cursor.Cursor
+	if len(results) == limit {
+		last := results[len(results)-1]
+		nextCursor = &cursor.Cursor{CreatedAt: last.CreatedAt.UnixMilli(), ID: int64(last.VideoID)}
 	}
 
 	res := &favorite.FavoriteListResponse{
 		StatusCode: 0,
 		StatusMsg:  "success",
 		VideoList:  favorites,
+		NextCursor: cursor.Encode(nextCursor),
 	}
 	return res, nil
 }
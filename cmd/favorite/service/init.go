@@ -16,8 +16,9 @@ var (
 	err        error
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
 	//GoCron()
 	go consume()
 }
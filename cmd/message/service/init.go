@@ -11,8 +11,9 @@ var (
 	privateKey string
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
 	publicKey, _ = tool.ReadKeyFromFile(tool.PublicKeyFilePath)
 	privateKey, _ = tool.ReadKeyFromFile(tool.PrivateKeyFilePath)
 }
@@ -146,7 +146,7 @@ func (s *RelationServiceImpl) RelationFollowList(ctx context.Context, req *relat
 	}
 	userList := make([]*user.User, 0)
 	for _, u := range users {
-		avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, u.Avatar)
+		avatar, err := minio.MediaURL(minio.AvatarBucketName, u.Avatar)
 		if err != nil {
 			logger.Errorf("Minio获取头像失败：%v", err.Error())
 			res := &relation.RelationFollowListResponse{
@@ -155,7 +155,7 @@ func (s *RelationServiceImpl) RelationFollowList(ctx context.Context, req *relat
 			}
 			return res, nil
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, u.BackgroundImage)
+		backgroundUrl, err := minio.MediaURL(minio.BackgroundImageBucketName, u.BackgroundImage)
 		if err != nil {
 			logger.Errorf("Minio获取背景图链接失败：%v", err.Error())
 			res := &relation.RelationFollowListResponse{
@@ -247,7 +247,7 @@ func (s *RelationServiceImpl) RelationFollowerList(ctx context.Context, req *rel
 			}
 			return res, nil
 		}
-		avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, u.Avatar)
+		avatar, err := minio.MediaURL(minio.AvatarBucketName, u.Avatar)
 		if err != nil {
 			logger.Errorf("Minio获取头像失败：%v", err.Error())
 			res := &relation.RelationFollowerListResponse{
@@ -256,7 +256,7 @@ func (s *RelationServiceImpl) RelationFollowerList(ctx context.Context, req *rel
 			}
 			return res, nil
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, u.BackgroundImage)
+		backgroundUrl, err := minio.MediaURL(minio.BackgroundImageBucketName, u.BackgroundImage)
 		if err != nil {
 			logger.Errorf("Minio获取背景图链接失败：%v", err.Error())
 			res := &relation.RelationFollowerListResponse{
@@ -375,7 +375,7 @@ func (s *RelationServiceImpl) RelationFriendList(ctx context.Context, req *relat
 				return res, nil
 			}
 		}
-		avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, u.Avatar)
+		avatar, err := minio.MediaURL(minio.AvatarBucketName, u.Avatar)
 		if err != nil {
 			logger.Errorf("Minio获取头像失败：%v", err.Error())
 			res := &relation.RelationFriendListResponse{
@@ -384,7 +384,7 @@ func (s *RelationServiceImpl) RelationFriendList(ctx context.Context, req *relat
 			}
 			return res, nil
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, u.BackgroundImage)
+		backgroundUrl, err := minio.MediaURL(minio.BackgroundImageBucketName, u.BackgroundImage)
 		if err != nil {
 			logger.Errorf("Minio获取背景图失败：%v", err.Error())
 			res := &relation.RelationFriendListResponse{
@@ -19,8 +19,9 @@ var (
 	privateKey string
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
 	privateKey, _ = tool.ReadKeyFromFile(tool.PrivateKeyFilePath)
 	//GoCron()
 	go consume()
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/assembler"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	search "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/search"
+)
+
+// SearchServiceImpl implements the last service interface defined in the IDL.
+type SearchServiceImpl struct{}
+
+const (
+	// limit 每页的结果条数
+	limit = 20
+	// maxOffset 允许翻页的最大偏移量，避免深度分页拖慢全文检索
+	maxOffset = 1000
+)
+
+// parseViewer 解析可选的token，未登录时返回 -1
+func parseViewer(token string) (int64, error) {
+	if token == "" {
+		return -1, nil
+	}
+	claims, err := Jwt.ParseToken(token)
+	if err != nil {
+		return 0, err
+	}
+	return claims.Id, nil
+}
+
+// nextOffset 计算下一页的偏移量，结果不足一页或超出最大偏移量时返回 0 表示没有更多结果
+func nextOffset(offset int64, count int) int64 {
+	if count < limit || offset+int64(count) >= maxOffset {
+		return 0
+	}
+	return offset + int64(count)
+}
+
+// SearchUser implements the SearchServiceImpl interface.
+func (s *SearchServiceImpl) SearchUser(ctx context.Context, req *search.SearchUserRequest) (resp *search.SearchUserResponse, err error) {
+	userID, err := parseViewer(req.Token)
+	if err != nil {
+		logger.Errorf("token解析错误：%v", err.Error())
+		res := &search.SearchUserResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	query := tool.FulltextQuery(req.Keyword)
+	if query == "" || req.Offset < 0 || req.Offset >= maxOffset {
+		res := &search.SearchUserResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索参数不合法",
+		}
+		return res, nil
+	}
+
+	users, err := db.SearchUsers(ctx, query, int(req.Offset), limit)
+	if err != nil {
+		logger.Errorf("搜索用户错误：%v", err.Error())
+		res := &search.SearchUserResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	userList, err := assembler.Users(ctx, users, userID)
+	if err != nil {
+		logger.Errorf("组装用户错误：%v", err.Error())
+		res := &search.SearchUserResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索失败：服务器内部错误",
+		}
+		return res, nil
+	}
+
+	res := &search.SearchUserResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		UserList:   userList,
+		NextOffset: nextOffset(req.Offset, len(users)),
+	}
+	return res, nil
+}
+
+// SearchVideo implements the SearchServiceImpl interface.
+func (s *SearchServiceImpl) SearchVideo(ctx context.Context, req *search.SearchVideoRequest) (resp *search.SearchVideoResponse, err error) {
+	userID, err := parseViewer(req.Token)
+	if err != nil {
+		logger.Errorf("token解析错误：%v", err.Error())
+		res := &search.SearchVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	query := tool.FulltextQuery(req.Keyword)
+	if query == "" || req.Offset < 0 || req.Offset >= maxOffset {
+		res := &search.SearchVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索参数不合法",
+		}
+		return res, nil
+	}
+
+	videos, err := db.SearchVideos(ctx, query, userID, int(req.Offset), limit)
+	if err != nil {
+		logger.Errorf("搜索视频错误：%v", err.Error())
+		res := &search.SearchVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	videoList, err := assembler.Videos(ctx, videos, userID)
+	if err != nil {
+		logger.Errorf("组装视频错误：%v", err.Error())
+		res := &search.SearchVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "搜索失败：服务器内部错误",
+		}
+		return res, nil
+	}
+
+	res := &search.SearchVideoResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		VideoList:  videoList,
+		NextOffset: nextOffset(req.Offset, len(videos)),
+	}
+	return res, nil
+}
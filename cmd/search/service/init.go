@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+var (
+	Jwt    *jwt.JWT
+	logger = zap.InitLogger()
+)
+
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
+}
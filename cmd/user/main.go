@@ -7,6 +7,7 @@ import (
 	"github.com/bytedance-youthcamp-jbzx/tiktok/cmd/user/service"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user/userservice"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/etcd"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/middleware"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
@@ -19,12 +20,12 @@ var (
 	serviceName = config.Viper.GetString("server.name")
 	serviceAddr = fmt.Sprintf("%s:%d", config.Viper.GetString("server.host"), config.Viper.GetInt("server.port"))
 	etcdAddr    = fmt.Sprintf("%s:%d", config.Viper.GetString("etcd.host"), config.Viper.GetInt("etcd.port"))
-	signingKey  = config.Viper.GetString("JWT.signingKey")
 	logger      = zap.InitLogger()
+	keyConfig   = jwt.LoadKeyConfig(config)
 )
 
 func init() {
-	service.Init(signingKey)
+	service.Init(keyConfig)
 }
 
 func main() {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
-	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
 	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
@@ -39,10 +38,19 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.UserRegisterRe
 	}
 
 	// 创建user
+	password, err := Password.Hash(req.Password)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.UserRegisterResponse{
+			StatusCode: -1,
+			StatusMsg:  "注册失败：服务器内部错误",
+		}
+		return res, nil
+	}
 	rand.Seed(time.Now().UnixMilli())
 	usr = &db.User{
 		UserName: req.Username,
-		Password: tool.Md5Encrypt(req.Password),
+		Password: password,
 		Avatar:   fmt.Sprintf("default%d.png", rand.Intn(10)),
 	}
 	if err := db.CreateUser(ctx, usr); err != nil {
@@ -79,9 +87,7 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.UserRegisterRe
 	//}
 
 	//生成token
-	claims := jwt.CustomClaims{Id: int64(usr.ID)}
-	claims.ExpiresAt = time.Now().Add(time.Minute * 5).Unix()
-	token, err := Jwt.CreateToken(claims)
+	pair, err := startSession(ctx, int64(usr.ID), jwt.AuthLevelPassword, req.Client)
 	if err != nil {
 		logger.Errorf("发生错误：%v", err.Error())
 		res := &user.UserRegisterResponse{
@@ -91,10 +97,11 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.UserRegisterRe
 		return res, nil
 	}
 	res := &user.UserRegisterResponse{
-		StatusCode: 0,
-		StatusMsg:  "success",
-		UserId:     int64(usr.ID),
-		Token:      token,
+		StatusCode:   0,
+		StatusMsg:    "success",
+		UserId:       int64(usr.ID),
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
 	}
 	return res, nil
 }
@@ -103,10 +110,22 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.UserRegisterRe
 func (s *UserServiceImpl) Login(ctx context.Context, req *user.UserLoginRequest) (resp *user.UserLoginResponse, err error) {
 	logger := zap.InitLogger()
 
+	// 用户名或 IP 登录失败次数过多时，等待期内直接拒绝，否则在校验密码前预占一次尝试
+	attempt, blocked, retryAfter := reserveLoginAttempt(ctx, loginLimits(req.Username, req.Client.GetIp()))
+	if blocked != nil {
+		res := &user.UserLoginResponse{
+			StatusCode: int32(blocked.ErrCode),
+			StatusMsg:  blocked.ErrMsg,
+			RetryAfter: retryAfter,
+		}
+		return res, nil
+	}
+
 	// 根据用户名获取密码
 	usr, err := db.GetUserByName(ctx, req.Username)
 	if err != nil {
 		logger.Errorln(err.Error())
+		attempt.cancel(ctx)
 		res := &user.UserLoginResponse{
 			StatusCode: -1,
 			StatusMsg:  "登录失败：服务器内部错误",
@@ -117,25 +136,74 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.UserLoginRequest)
 			StatusCode: -1,
 			StatusMsg:  "用户名不存在",
 		}
+		if blocked, retryAfter := attempt.fail(); blocked != nil {
+			res.StatusCode, res.StatusMsg, res.RetryAfter = int32(blocked.ErrCode), blocked.ErrMsg, retryAfter
+		}
 		return res, nil
 	}
 
 	// 比较数据库中的密码和请求的密码
-	if tool.Md5Encrypt(req.Password) != usr.Password {
+	ok, rehash, err := Password.Verify(req.Password, usr.Password)
+	if err != nil {
+		logger.Errorf("密码哈希无法识别：%v", err.Error())
+		attempt.cancel(ctx)
+		res := &user.UserLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录失败：服务器内部错误",
+		}
+		return res, nil
+	} else if !ok {
 		logger.Errorln("用户名或密码错误")
 		res := &user.UserLoginResponse{
 			StatusCode: -1,
 			StatusMsg:  "用户名或密码错误",
 		}
+		if blocked, retryAfter := attempt.fail(); blocked != nil {
+			res.StatusCode, res.StatusMsg, res.RetryAfter = int32(blocked.ErrCode), blocked.ErrMsg, retryAfter
+		}
 		return res, nil
 	}
+	attempt.succeed(ctx)
+	// 旧算法或旧参数生成的哈希，登录成功后使用当前算法重新计算，失败时不影响本次登录
+	if rehash {
+		if password, err := Password.Hash(req.Password); err != nil {
+			logger.Errorf("密码哈希升级失败：%v", err.Error())
+		} else if _, err := db.UpdateUserPassword(ctx, int64(usr.ID), usr.Password, password); err != nil {
+			logger.Errorf("密码哈希升级失败：%v", err.Error())
+		}
+	}
 
-	// 密码认证通过,获取用户id并生成token
-	claims := jwt.CustomClaims{
-		Id: int64(usr.ID),
+	// 已开启两步验证时，返回挑战令牌，验证码校验通过后再创建登录会话
+	totp, err := db.GetUserTOTP(ctx, int64(usr.ID))
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.UserLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录失败：服务器内部错误",
+		}
+		return res, nil
+	} else if totp != nil && totp.Enabled() {
+		challenge, err := Jwt.CreateChallengeToken(int64(usr.ID), config.Viper.GetDuration("user.totp.challengeTTL"))
+		if err != nil {
+			logger.Errorf("发生错误：%v", err.Error())
+			res := &user.UserLoginResponse{
+				StatusCode: -1,
+				StatusMsg:  "服务器内部错误：token 创建失败",
+			}
+			return res, nil
+		}
+		res := &user.UserLoginResponse{
+			StatusCode:     0,
+			StatusMsg:      "请输入两步验证码",
+			UserId:         int64(usr.ID),
+			MfaRequired:    true,
+			ChallengeToken: challenge,
+		}
+		return res, nil
 	}
-	claims.ExpiresAt = time.Now().Add(time.Hour * 24).Unix()
-	token, err := Jwt.CreateToken(claims)
+
+	// 密码认证通过,创建登录会话并生成token
+	pair, err := startSession(ctx, int64(usr.ID), jwt.AuthLevelPassword, req.Client)
 	if err != nil {
 		logger.Errorf("发生错误：%v", err.Error())
 		res := &user.UserLoginResponse{
@@ -147,10 +215,11 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.UserLoginRequest)
 
 	// 返回结果
 	res := &user.UserLoginResponse{
-		StatusCode: 0,
-		StatusMsg:  "success",
-		UserId:     int64(usr.ID),
-		Token:      token,
+		StatusCode:   0,
+		StatusMsg:    "success",
+		UserId:       int64(usr.ID),
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
 	}
 	return res, nil
 }
@@ -178,7 +247,7 @@ func (s *UserServiceImpl) UserInfo(ctx context.Context, req *user.UserInfoReques
 		return res, nil
 	}
 
-	avatar, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, usr.Avatar)
+	avatar, err := minio.MediaURL(minio.AvatarBucketName, usr.Avatar)
 	if err != nil {
 		logger.Errorf("Minio获取头像失败：%v", err.Error())
 		res := &user.UserInfoResponse{
@@ -187,7 +256,7 @@ func (s *UserServiceImpl) UserInfo(ctx context.Context, req *user.UserInfoReques
 		}
 		return res, nil
 	}
-	backgroundImage, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, usr.BackgroundImage)
+	backgroundImage, err := minio.MediaURL(minio.BackgroundImageBucketName, usr.BackgroundImage)
 	if err != nil {
 		logger.Errorf("Minio获取背景图失败：%v", err.Error())
 		res := &user.UserInfoResponse{
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// profileImage 头像或背景图的处理规则
+type profileImage struct {
+	bucket  string
+	maxSize int // 文件大小上限（MB）
+	width   int
+	height  int
+	update  func(ctx context.Context, userID int64, objectName string) (string, bool, error)
+}
+
+func avatarImage() profileImage {
+	size := config.Viper.GetInt("user.avatar.size")
+	return profileImage{
+		bucket:  minio.AvatarBucketName,
+		maxSize: config.Viper.GetInt("user.avatar.maxSizeLimit"),
+		width:   size,
+		height:  size,
+		update:  db.UpdateUserAvatar,
+	}
+}
+
+func backgroundImage() profileImage {
+	return profileImage{
+		bucket:  minio.BackgroundImageBucketName,
+		maxSize: config.Viper.GetInt("user.background.maxSizeLimit"),
+		width:   config.Viper.GetInt("user.background.width"),
+		height:  config.Viper.GetInt("user.background.height"),
+		update:  db.UpdateUserBackgroundImage,
+	}
+}
+
+// isDefaultImage 注册时分配的默认头像与默认背景图为共享对象，对象名不含用户目录，替换时不能删除
+func isDefaultImage(objectName string) bool {
+	return !strings.Contains(objectName, "/")
+}
+
+// replaceProfileImage 校验图片，缩放裁剪后上传，更新用户数据并删除原图片，返回新图片的访问链接
+func replaceProfileImage(ctx context.Context, userID int64, data []byte, p profileImage) (string, error) {
+	logger := zap.InitLogger()
+
+	if len(data) == 0 {
+		return "", errno.ErrImageInvalidFormat.WithMessage("图片不能为空")
+	}
+	if len(data) > p.maxSize*1000*1000 {
+		return "", errno.ErrImageSizeExceeded.WithMessage(fmt.Sprintf("图片不能大于%dMB", p.maxSize))
+	}
+	img, err := tool.DecodeImage(data, config.Viper.GetInt("user.image.maxPixels"))
+	if err == tool.ErrImageTooLarge {
+		return "", errno.ErrImageResolutionExceeded.WithMessage("图片分辨率过大")
+	} else if err != nil {
+		return "", errno.ErrImageInvalidFormat.WithMessage("不支持的图片格式")
+	}
+	buf, err := tool.FillJPEG(img, p.width, p.height)
+	if err != nil {
+		logger.Errorf("图片编码失败：%v", err.Error())
+		return "", err
+	}
+
+	objectName := tool.NewObjectKey(userID) + ".jpg"
+	if _, err := minio.UploadFileByIO(p.bucket, objectName, buf, int64(buf.Len()), "image/jpeg"); err != nil {
+		logger.Errorf("图片上传至minio失败：%v", err.Error())
+		return "", err
+	}
+
+	old, found, err := p.update(ctx, userID, objectName)
+	if err != nil || !found {
+		if e := minio.RemoveFile(p.bucket, objectName); e != nil {
+			logger.Errorf("清理已上传的图片失败：%v", e.Error())
+		}
+		if err != nil {
+			logger.Errorln(err.Error())
+			return "", err
+		}
+		return "", errno.ErrUserNotFound.WithMessage("该用户不存在")
+	}
+	if old != "" && !isDefaultImage(old) {
+		if err := minio.RemoveFile(p.bucket, old); err != nil {
+			logger.Errorf("删除原图片失败：%v", err.Error())
+		}
+	}
+
+	url, err := minio.MediaURL(p.bucket, objectName)
+	if err != nil {
+		logger.Errorf("Minio获取图片链接失败：%v", err.Error())
+		return "", err
+	}
+	return url, nil
+}
+
+// UpdateAvatar implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateAvatar(ctx context.Context, req *user.UpdateAvatarRequest) (resp *user.UpdateAvatarResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.UpdateAvatarResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	avatar, err := replaceProfileImage(ctx, claims.Id, req.Data, avatarImage())
+	if err != nil {
+		res := &user.UpdateAvatarResponse{
+			StatusCode: -1,
+			StatusMsg:  "头像更新失败：服务器内部错误",
+		}
+		if e := (errno.ErrNo{}); errors.As(err, &e) {
+			res.StatusCode, res.StatusMsg = int32(e.ErrCode), e.ErrMsg
+		}
+		return res, nil
+	}
+
+	res := &user.UpdateAvatarResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Avatar:     avatar,
+	}
+	return res, nil
+}
+
+// UpdateBackground implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateBackground(ctx context.Context, req *user.UpdateBackgroundRequest) (resp *user.UpdateBackgroundResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.UpdateBackgroundResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	background, err := replaceProfileImage(ctx, claims.Id, req.Data, backgroundImage())
+	if err != nil {
+		res := &user.UpdateBackgroundResponse{
+			StatusCode: -1,
+			StatusMsg:  "背景图更新失败：服务器内部错误",
+		}
+		if e := (errno.ErrNo{}); errors.As(err, &e) {
+			res.StatusCode, res.StatusMsg = int32(e.ErrCode), e.ErrMsg
+		}
+		return res, nil
+	}
+
+	res := &user.UpdateBackgroundResponse{
+		StatusCode:      0,
+		StatusMsg:       "success",
+		BackgroundImage: background,
+	}
+	return res, nil
+}
@@ -1,13 +1,76 @@
 package service
 
 import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
 )
 
 var (
-	Jwt *jwt.JWT
+	Jwt    *jwt.JWT
+	config = viper.Init("user")
+	// Password 新密码使用配置的算法，旧版本的 MD5 哈希及其他算法的哈希仍可校验，登录成功后自动升级
+	Password *tool.PasswordManager
+	// totpKey 加密两步验证密钥及计算恢复码哈希的密钥，未配置时不支持开启两步验证
+	totpKey []byte
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+// loadTOTPKey 读取 Base64 编码的 32 字节密钥，相对路径以配置文件所在目录为准
+func loadTOTPKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(config.Viper.ConfigFileUsed()), path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("totp key must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
+}
+
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
+	hasher, err := tool.NewPasswordHasher(config.Viper.GetString("password.algorithm"), config.Viper.GetInt("password.bcrypt.cost"), tool.Argon2idHasher{
+		Memory:      config.Viper.GetUint32("password.argon2id.memory"),
+		Iterations:  config.Viper.GetUint32("password.argon2id.iterations"),
+		Parallelism: uint8(config.Viper.GetUint("password.argon2id.parallelism")),
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 其他算法仅用于校验，参数从哈希中解析
+	legacy := []tool.PasswordHasher{tool.MD5Hasher{}}
+	for _, h := range []tool.PasswordHasher{tool.BcryptHasher{}, tool.Argon2idHasher{}} {
+		if h.Algorithm() != hasher.Algorithm() {
+			legacy = append(legacy, h)
+		}
+	}
+	Password = tool.NewPasswordManager(hasher, legacy...)
+	if totpKey, err = loadTOTPKey(config.Viper.GetString("user.totp.keyFile")); err != nil {
+		panic(fmt.Errorf("load totp key: %w, run scripts/totp-key.sh to generate the key", err))
+	}
+	// 头像与背景图存储桶，创建失败不影响注册登录等功能
+	for _, bucket := range []string{minio.AvatarBucketName, minio.BackgroundImageBucketName} {
+		if err := minio.CreateBucket(bucket); err != nil {
+			zap.InitLogger().Errorf("存储桶 %s 创建失败：%s", bucket, err.Error())
+		}
+	}
 }
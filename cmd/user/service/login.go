@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/redis"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+	"github.com/google/uuid"
+)
+
+// loginLimit 登录失败次数的统计对象
+type loginLimit struct {
+	scope string
+	id    string
+}
+
+// loginLimits 返回本次登录需要统计的用户名与 IP，用户名不区分大小写，与数据库的比较规则一致
+func loginLimits(username, ip string) []loginLimit {
+	limits := []loginLimit{{scope: redis.LoginScopeUser, id: strings.ToLower(username)}}
+	if ip != "" {
+		limits = append(limits, loginLimit{scope: redis.LoginScopeIP, id: ip})
+	}
+	return limits
+}
+
+// mfaLimits 两步验证码的失败次数按用户统计
+func mfaLimits(userID int64) []loginLimit {
+	return []loginLimit{{scope: redis.LoginScopeMFA, id: strconv.FormatInt(userID, 10)}}
+}
+
+// loginPolicy 读取配置中的退避与锁定策略
+func loginPolicy(scope string) tool.LockoutPolicy {
+	prefix := "user.login." + scope + "."
+	return tool.LockoutPolicy{
+		FreeAttempts: config.Viper.GetInt(prefix + "freeAttempts"),
+		MaxAttempts:  config.Viper.GetInt(prefix + "maxAttempts"),
+		BackoffBase:  config.Viper.GetDuration("user.login.backoffBase"),
+		BackoffMax:   config.Viper.GetDuration("user.login.backoffMax"),
+		LockDuration: config.Viper.GetDuration(prefix + "lockDuration"),
+	}
+}
+
+// loginBlockedError 根据限制类型返回错误码及提示
+func loginBlockedError(scope, kind string, retryAfter time.Duration) (errno.ErrNo, int64) {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	switch {
+	case scope == redis.LoginScopeIP && kind == redis.LoginBlockLocked:
+		return errno.ErrLoginIPLocked.WithMessage(fmt.Sprintf("当前网络登录失败次数过多，请%d秒后重试", seconds)), seconds
+	case kind == redis.LoginBlockLocked:
+		return errno.ErrAccountLocked.WithMessage(fmt.Sprintf("账号已被临时锁定，请%d秒后重试", seconds)), seconds
+	default:
+		return errno.ErrLoginBackoff.WithMessage(fmt.Sprintf("登录失败次数过多，请%d秒后重试", seconds)), seconds
+	}
+}
+
+// loginAttempt 校验密码或验证码前预占的一次尝试，预占时已按失败计数并提前设置失败后的限制，
+// 并发的请求无法在校验结果写入前绕过限制
+type loginAttempt struct {
+	id       string
+	limits   []loginLimit
+	pending  []redis.LoginBlock // 本次尝试失败后生效的限制
+	failures []int64
+}
+
+// reserveLoginAttempt 校验前原子地检查用户名、IP 等是否受限并预占一次尝试，受限时返回等待时间最长的限制。
+// 读写 Redis 失败时不限制登录，仍由密码校验保证安全
+func reserveLoginAttempt(ctx context.Context, limits []loginLimit) (*loginAttempt, *errno.ErrNo, int64) {
+	reserve := make([]redis.LoginLimit, 0, len(limits))
+	for _, l := range limits {
+		delays, locked := loginPolicy(l.scope).Schedule()
+		reserve = append(reserve, redis.LoginLimit{Scope: l.scope, ID: l.id, Delays: delays, Locked: locked})
+	}
+	id := uuid.New().String()
+	blocked, pending, failures, err := redis.ReserveLoginAttempt(ctx, config.Viper.GetDuration("user.login.window"), id, reserve...)
+	if err != nil {
+		zap.InitLogger().Errorf("预占登录尝试失败：%v", err.Error())
+		return &loginAttempt{}, nil, 0
+	}
+	if blocked != nil {
+		e, seconds := loginBlockedError(blocked.Scope, blocked.Kind, blocked.RetryAfter)
+		return nil, &e, seconds
+	}
+	return &loginAttempt{id: id, limits: limits, pending: pending, failures: failures}, nil, 0
+}
+
+// fail 校验失败，失败次数已在预占时记录，返回本次失败后生效的限制
+func (a *loginAttempt) fail() (*errno.ErrNo, int64) {
+	var (
+		blocked    *errno.ErrNo
+		retryAfter int64
+	)
+	for i, b := range a.pending {
+		if b.Kind == "" {
+			continue
+		}
+		if b.Kind == redis.LoginBlockLocked {
+			zap.InitLogger().Errorf("登录失败次数过多，临时锁定 %s %s：%d次", b.Scope, b.ID, a.failures[i])
+		}
+		if e, seconds := loginBlockedError(b.Scope, b.Kind, b.RetryAfter); seconds > retryAfter {
+			blocked, retryAfter = &e, seconds
+		}
+	}
+	return blocked, retryAfter
+}
+
+// succeed 校验成功，清除用户名及两步验证的失败次数与限制；IP 仅撤销本次预占及本次提前设置的限制，
+// 其余失败次数在统计窗口结束后自动清除
+func (a *loginAttempt) succeed(ctx context.Context) {
+	for _, l := range a.limits {
+		var err error
+		if l.scope == redis.LoginScopeIP {
+			err = redis.ReleaseLoginAttempt(ctx, l.scope, l.id, a.id)
+		} else {
+			err = redis.ResetLoginFailure(ctx, l.scope, l.id)
+		}
+		if err != nil {
+			zap.InitLogger().Errorf("清除登录失败次数失败：%v", err.Error())
+		}
+	}
+}
+
+// cancel 服务器内部错误等未能完成校验时撤销本次预占及本次提前设置的限制，不计为失败
+func (a *loginAttempt) cancel(ctx context.Context) {
+	for _, l := range a.limits {
+		if err := redis.ReleaseLoginAttempt(ctx, l.scope, l.id, a.id); err != nil {
+			zap.InitLogger().Errorf("撤销登录尝试失败：%v", err.Error())
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// signatureMaxBytes 个人简介列的长度上限
+const signatureMaxBytes = 256
+
+// validateUserName 校验新的用户名，返回去除首尾空白后的用户名，不合法时返回错误提示
+func validateUserName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", "用户名不能为空"
+	}
+	if maxLength := config.Viper.GetInt("user.profile.nameMaxLength"); len(name) > maxLength {
+		return "", fmt.Sprintf("用户名长度不能大于%d个字符", maxLength)
+	}
+	if !utf8.ValidString(name) {
+		return "", "用户名包含非法字符"
+	}
+	for _, r := range name {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || !unicode.IsPrint(r) {
+			return "", "用户名不能包含空白或不可见字符"
+		}
+	}
+	return name, ""
+}
+
+// validateSignature 校验新的个人简介，允许换行，返回去除首尾空白后的个人简介，不合法时返回错误提示
+func validateSignature(signature string) (string, string) {
+	signature = strings.TrimSpace(signature)
+	if !utf8.ValidString(signature) {
+		return "", "个人简介包含非法字符"
+	}
+	if maxLength := config.Viper.GetInt("user.profile.signatureMaxLength"); utf8.RuneCountInString(signature) > maxLength || len(signature) > signatureMaxBytes {
+		return "", fmt.Sprintf("个人简介不能超过%d个字", maxLength)
+	}
+	for _, r := range signature {
+		if r != '\n' && (unicode.IsControl(r) || (!unicode.IsPrint(r) && !unicode.IsSpace(r))) {
+			return "", "个人简介不能包含不可见字符"
+		}
+	}
+	return signature, ""
+}
+
+// UpdateProfile implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (resp *user.UpdateProfileResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	if msg := requireMFA(ctx, claims); msg != "" {
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	var name, signature *string
+	if req.Name != "" {
+		n, msg := validateUserName(req.Name)
+		if msg != "" {
+			res := &user.UpdateProfileResponse{
+				StatusCode: -1,
+				StatusMsg:  msg,
+			}
+			return res, nil
+		}
+		name = &n
+	}
+	if req.SetSignature {
+		sig, msg := validateSignature(req.Signature)
+		if msg != "" {
+			res := &user.UpdateProfileResponse{
+				StatusCode: -1,
+				StatusMsg:  msg,
+			}
+			return res, nil
+		}
+		signature = &sig
+	}
+	if name == nil && signature == nil {
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  "未指定需要修改的资料",
+		}
+		return res, nil
+	}
+
+	renameWindow := config.Viper.GetDuration("user.profile.renameWindow")
+	usr, err := db.UpdateUserProfile(ctx, claims.Id, name, signature, config.Viper.GetInt("user.profile.renameLimit"), renameWindow)
+	switch {
+	case err == db.ErrUserNameTaken:
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  "该用户名已存在，请更换",
+		}
+		return res, nil
+	case err == db.ErrRenameTooOften:
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  fmt.Sprintf("用户名在%.0f天内最多修改%d次", renameWindow.Hours()/24, config.Viper.GetInt("user.profile.renameLimit")),
+		}
+		return res, nil
+	case err != nil:
+		logger.Errorln(err.Error())
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  "资料修改失败：服务器内部错误",
+		}
+		return res, nil
+	case usr == nil:
+		res := &user.UpdateProfileResponse{
+			StatusCode: -1,
+			StatusMsg:  "该用户不存在",
+		}
+		return res, nil
+	}
+
+	res := &user.UpdateProfileResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Name:       usr.UserName,
+		Signature:  usr.Signature,
+	}
+	return res, nil
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/redis"
+	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+	"github.com/google/uuid"
+)
+
+// 会话字段的长度上限，与数据库列宽一致
+const (
+	sessionDeviceMaxBytes    = 64
+	sessionIPMaxBytes        = 64
+	sessionUserAgentMaxBytes = 256
+)
+
+// truncate 按字节截断字符串，不截断多字节字符
+func truncate(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	for maxBytes > 0 && !utf8.RuneStart(s[maxBytes]) {
+		maxBytes--
+	}
+	return s[:maxBytes]
+}
+
+// startSession 创建登录会话，并签发属于该会话、认证强度为 authLevel 的令牌
+func startSession(ctx context.Context, userID int64, authLevel int, client *user.ClientInfo) (*jwt.TokenPair, error) {
+	sessionID := uuid.New().String()
+	pair, err := createTokenPair(userID, sessionID, authLevel)
+	if err != nil {
+		return nil, err
+	}
+	session := &db.UserSession{
+		ID:         sessionID,
+		UserID:     uint(userID),
+		Device:     truncate(client.GetDevice(), sessionDeviceMaxBytes),
+		IP:         truncate(client.GetIp(), sessionIPMaxBytes),
+		UserAgent:  truncate(client.GetUserAgent(), sessionUserAgentMaxBytes),
+		LastSeenAt: time.Now(),
+		ExpiresAt:  pair.RefreshExpiresAt,
+	}
+	if err := db.CreateUserSession(ctx, session); err != nil {
+		return nil, err
+	}
+	clearSessionsCache(ctx, userID)
+	return pair, nil
+}
+
+// refreshSession 为会话签发新的令牌并更新会话的最近使用时间，令牌的认证强度保持不变，会话已吊销或已过期时返回 nil
+func refreshSession(ctx context.Context, userID int64, sessionID string, authLevel int, client *user.ClientInfo) (*jwt.TokenPair, error) {
+	pair, err := createTokenPair(userID, sessionID, authLevel)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := db.TouchUserSession(ctx, sessionID, userID, truncate(client.GetIp(), sessionIPMaxBytes), pair.RefreshExpiresAt)
+	if err != nil || !ok {
+		return nil, err
+	}
+	clearSessionsCache(ctx, userID)
+	return pair, nil
+}
+
+// revokeSession 吊销会话，会话内已签发的令牌立即失效，会话不存在或不属于该用户时返回 false
+func revokeSession(ctx context.Context, userID int64, sessionID string) (bool, error) {
+	session, err := db.RevokeUserSession(ctx, sessionID, userID)
+	if err != nil || session == nil {
+		return false, err
+	}
+	if err := Jwt.RevokeSession(ctx, sessionID, session.ExpiresAt); err != nil {
+		return false, err
+	}
+	clearSessionsCache(ctx, userID)
+	return true, nil
+}
+
+// getActiveSessions 获取用户的有效会话，优先读取缓存
+func getActiveSessions(ctx context.Context, userID int64) ([]*db.UserSession, error) {
+	logger := zap.InitLogger()
+	sessions, err := redis.GetUserSessions(ctx, userID)
+	if err != nil {
+		logger.Errorf("读取会话缓存失败：%v", err.Error())
+	} else if sessions != nil {
+		// 缓存期间可能有会话过期
+		res := make([]*db.UserSession, 0, len(sessions))
+		for _, s := range sessions {
+			if s.ExpiresAt.After(time.Now()) {
+				res = append(res, s)
+			}
+		}
+		return res, nil
+	}
+
+	sessions, err = db.GetActiveUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := redis.SetUserSessions(ctx, userID, sessions, config.Viper.GetDuration("user.session.cacheTTL")); err != nil {
+		logger.Errorf("写入会话缓存失败：%v", err.Error())
+	}
+	return sessions, nil
+}
+
+// clearSessionsCache 会话变化后删除缓存，删除失败时缓存在过期前可能不是最新
+func clearSessionsCache(ctx context.Context, userID int64) {
+	if err := redis.DeleteUserSessions(ctx, userID); err != nil {
+		zap.InitLogger().Errorf("删除会话缓存失败：%v", err.Error())
+	}
+}
+
+// ListSessions implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListSessions(ctx context.Context, req *user.ListSessionsRequest) (resp *user.ListSessionsResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.ListSessionsResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	if msg := requireMFA(ctx, claims); msg != "" {
+		res := &user.ListSessionsResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	sessions, err := getActiveSessions(ctx, claims.Id)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.ListSessionsResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：获取登录设备失败",
+		}
+		return res, nil
+	}
+
+	list := make([]*user.Session, 0, len(sessions))
+	for _, session := range sessions {
+		list = append(list, &user.Session{
+			Id:         session.ID,
+			Device:     session.Device,
+			Ip:         session.IP,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastSeenAt: session.LastSeenAt.Unix(),
+			Current:    session.ID == claims.SessionID,
+		})
+	}
+	res := &user.ListSessionsResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Sessions:   list,
+	}
+	return res, nil
+}
+
+// RevokeSession implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeSession(ctx context.Context, req *user.RevokeSessionRequest) (resp *user.RevokeSessionResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.RevokeSessionResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	if req.SessionId == "" {
+		res := &user.RevokeSessionResponse{
+			StatusCode: -1,
+			StatusMsg:  "session_id 不能为空",
+		}
+		return res, nil
+	}
+
+	if msg := requireMFA(ctx, claims); msg != "" {
+		res := &user.RevokeSessionResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	found, err := revokeSession(ctx, claims.Id, req.SessionId)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.RevokeSessionResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：登出设备失败",
+		}
+		return res, nil
+	} else if !found {
+		res := &user.RevokeSessionResponse{
+			StatusCode: -1,
+			StatusMsg:  "该登录设备不存在",
+		}
+		return res, nil
+	}
+
+	res := &user.RevokeSessionResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+
+	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// createTokenPair 按配置的有效期签发属于会话 sessionID 的访问令牌与刷新令牌
+func createTokenPair(userID int64, sessionID string, authLevel int) (*jwt.TokenPair, error) {
+	return Jwt.CreateTokenPair(userID, sessionID, authLevel, config.Viper.GetDuration("JWT.accessTTL"), config.Viper.GetDuration("JWT.refreshTTL"))
+}
+
+// RefreshToken implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (resp *user.RefreshTokenResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.RefreshTokenResponse{
+			StatusCode: -1,
+			StatusMsg:  "refresh_token 无效或已过期，请重新登录",
+		}
+		return res, nil
+	}
+
+	// 刷新令牌只能使用一次，吊销失败或已被使用时拒绝刷新
+	first, err := Jwt.RevokeToken(ctx, claims)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.RefreshTokenResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：token 刷新失败",
+		}
+		return res, nil
+	} else if !first {
+		logger.Errorf("刷新令牌被重复使用：%s", claims.TokenID())
+		res := &user.RefreshTokenResponse{
+			StatusCode: -1,
+			StatusMsg:  "refresh_token 无效或已过期，请重新登录",
+		}
+		return res, nil
+	}
+
+	// 旧版本签发的令牌不属于任何会话，刷新时创建新的会话
+	var pair *jwt.TokenPair
+	if claims.SessionID == "" {
+		pair, err = startSession(ctx, claims.Id, claims.Level(), req.Client)
+	} else {
+		pair, err = refreshSession(ctx, claims.Id, claims.SessionID, claims.Level(), req.Client)
+	}
+	if err != nil {
+		logger.Errorf("发生错误：%v", err.Error())
+		res := &user.RefreshTokenResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：token 创建失败",
+		}
+		return res, nil
+	} else if pair == nil {
+		res := &user.RefreshTokenResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录已失效，请重新登录",
+		}
+		return res, nil
+	}
+	res := &user.RefreshTokenResponse{
+		StatusCode:   0,
+		StatusMsg:    "success",
+		UserId:       claims.Id,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}
+	return res, nil
+}
+
+// Logout implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Logout(ctx context.Context, req *user.LogoutRequest) (resp *user.LogoutResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.LogoutResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	toRevoke := []*jwt.CustomClaims{claims}
+	if req.RefreshToken != "" {
+		// 已失效的刷新令牌无需吊销，但不能吊销其他用户的刷新令牌
+		if refresh, err := Jwt.ParseRefreshToken(req.RefreshToken); err == nil {
+			if refresh.Id != claims.Id {
+				res := &user.LogoutResponse{
+					StatusCode: -1,
+					StatusMsg:  "refresh_token 与当前用户不匹配",
+				}
+				return res, nil
+			}
+			toRevoke = append(toRevoke, refresh)
+		}
+	}
+	for _, c := range toRevoke {
+		if c.TokenID() == "" {
+			// 旧版本签发的令牌没有 jti，无法吊销，只能等待其过期
+			continue
+		}
+		if _, err := Jwt.RevokeToken(ctx, c); err != nil {
+			logger.Errorln(err.Error())
+			res := &user.LogoutResponse{
+				StatusCode: -1,
+				StatusMsg:  "服务器内部错误：登出失败",
+			}
+			return res, nil
+		}
+	}
+
+	// 登出当前设备，会话内的其他令牌一并失效
+	if claims.SessionID != "" {
+		if _, err := revokeSession(ctx, claims.Id, claims.SessionID); err != nil {
+			logger.Errorln(err.Error())
+			res := &user.LogoutResponse{
+				StatusCode: -1,
+				StatusMsg:  "服务器内部错误：登出失败",
+			}
+			return res, nil
+		}
+	}
+
+	res := &user.LogoutResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
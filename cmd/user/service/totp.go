@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// isTOTPCode 判断输入的是验证码还是恢复码
+func isTOTPCode(code string) bool {
+	code = strings.TrimSpace(code)
+	if len(code) != tool.TOTPDigits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyTOTP 校验验证码，allowRecovery 为 true 时也接受恢复码，enable 为 true 时校验通过后确认绑定。
+// 每个验证码及恢复码只能使用一次
+func verifyTOTP(ctx context.Context, userID int64, totp *db.UserTOTP, code string, allowRecovery, enable bool) (bool, error) {
+	if !isTOTPCode(code) {
+		if !allowRecovery || strings.TrimSpace(code) == "" {
+			return false, nil
+		}
+		return db.UseUserRecoveryCode(ctx, userID, tool.HashRecoveryCode(totpKey, code))
+	}
+	secret, err := tool.AESGCMDecrypt(totpKey, totp.Secret)
+	if err != nil {
+		return false, err
+	}
+	step, ok, err := tool.VerifyTOTP(string(secret), code, time.Now(), config.Viper.GetInt("user.totp.skew"))
+	if err != nil || !ok {
+		return false, err
+	}
+	return db.UseUserTOTPStep(ctx, userID, step, enable)
+}
+
+// requireMFA 已开启两步验证的用户进行敏感操作时，要求令牌经过两步验证，不满足时返回错误提示
+func requireMFA(ctx context.Context, claims *jwt.CustomClaims) string {
+	if claims.RequireAuthLevel(jwt.AuthLevelMFA) == nil {
+		return ""
+	}
+	totp, err := db.GetUserTOTP(ctx, claims.Id)
+	if err != nil {
+		zap.InitLogger().Errorln(err.Error())
+		return "服务器内部错误：获取两步验证状态失败"
+	}
+	if totp != nil && totp.Enabled() {
+		return "该操作需要完成两步验证，请重新登录"
+	}
+	return ""
+}
+
+// EnrollTOTP implements the UserServiceImpl interface.
+func (s *UserServiceImpl) EnrollTOTP(ctx context.Context, req *user.EnrollTOTPRequest) (resp *user.EnrollTOTPResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	if totpKey == nil {
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "暂不支持两步验证",
+		}
+		return res, nil
+	}
+
+	if msg := requireMFA(ctx, claims); msg != "" {
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	usr, err := db.GetUserByID(ctx, claims.Id)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证绑定失败",
+		}
+		return res, nil
+	} else if usr == nil {
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "该用户不存在",
+		}
+		return res, nil
+	}
+
+	secret, err := tool.GenerateTOTPSecret()
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证绑定失败",
+		}
+		return res, nil
+	}
+	encrypted, err := tool.AESGCMEncrypt(totpKey, []byte(secret))
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证绑定失败",
+		}
+		return res, nil
+	}
+	codes, err := tool.GenerateRecoveryCodes(config.Viper.GetInt("user.totp.recoveryCodes"))
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证绑定失败",
+		}
+		return res, nil
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = tool.HashRecoveryCode(totpKey, code)
+	}
+
+	// 确认绑定前可重复调用，每次生成新的密钥及恢复码
+	saved, err := db.SaveUserTOTP(ctx, claims.Id, encrypted, hashes)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证绑定失败",
+		}
+		return res, nil
+	} else if !saved {
+		res := &user.EnrollTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "已开启两步验证，请先关闭后再重新绑定",
+		}
+		return res, nil
+	}
+
+	res := &user.EnrollTOTPResponse{
+		StatusCode:    0,
+		StatusMsg:     "success",
+		OtpauthUri:    tool.TOTPURI(config.Viper.GetString("user.totp.issuer"), usr.UserName, secret),
+		Secret:        secret,
+		RecoveryCodes: codes,
+	}
+	return res, nil
+}
+
+// ConfirmTOTP implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPRequest) (resp *user.ConfirmTOTPResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	totp, err := db.GetUserTOTP(ctx, claims.Id)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证开启失败",
+		}
+		return res, nil
+	} else if totp == nil {
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "请先绑定验证器",
+		}
+		return res, nil
+	} else if totp.Enabled() {
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "已开启两步验证",
+		}
+		return res, nil
+	}
+
+	attempt, blocked, _ := reserveLoginAttempt(ctx, mfaLimits(claims.Id))
+	if blocked != nil {
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: int32(blocked.ErrCode),
+			StatusMsg:  blocked.ErrMsg,
+		}
+		return res, nil
+	}
+	// 绑定时只接受验证码，以确认验证器已正确添加
+	ok, err := verifyTOTP(ctx, claims.Id, totp, req.Code, false, true)
+	if err != nil {
+		logger.Errorln(err.Error())
+		attempt.cancel(ctx)
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证开启失败",
+		}
+		return res, nil
+	} else if !ok {
+		res := &user.ConfirmTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "验证码错误",
+		}
+		if blocked, _ := attempt.fail(); blocked != nil {
+			res.StatusCode, res.StatusMsg = int32(blocked.ErrCode), blocked.ErrMsg
+		}
+		return res, nil
+	}
+	attempt.succeed(ctx)
+
+	res := &user.ConfirmTOTPResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
+
+// VerifyTOTPLogin implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyTOTPLogin(ctx context.Context, req *user.VerifyTOTPLoginRequest) (resp *user.VerifyTOTPLoginResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseChallengeToken(req.ChallengeToken)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录已过期，请重新输入密码",
+		}
+		return res, nil
+	}
+
+	totp, err := db.GetUserTOTP(ctx, claims.Id)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录失败：服务器内部错误",
+		}
+		return res, nil
+	} else if totp == nil || !totp.Enabled() {
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录已过期，请重新输入密码",
+		}
+		return res, nil
+	}
+
+	attempt, blocked, _ := reserveLoginAttempt(ctx, mfaLimits(claims.Id))
+	if blocked != nil {
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: int32(blocked.ErrCode),
+			StatusMsg:  blocked.ErrMsg,
+		}
+		return res, nil
+	}
+
+	ok, err := verifyTOTP(ctx, claims.Id, totp, req.Code, true, false)
+	if err != nil {
+		logger.Errorln(err.Error())
+		attempt.cancel(ctx)
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录失败：服务器内部错误",
+		}
+		return res, nil
+	} else if !ok {
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "验证码错误",
+		}
+		if blocked, _ := attempt.fail(); blocked != nil {
+			res.StatusCode, res.StatusMsg = int32(blocked.ErrCode), blocked.ErrMsg
+		}
+		return res, nil
+	}
+	attempt.succeed(ctx)
+
+	// 挑战令牌只能使用一次
+	first, err := Jwt.RevokeToken(ctx, claims)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录失败：服务器内部错误",
+		}
+		return res, nil
+	} else if !first {
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "登录已过期，请重新输入密码",
+		}
+		return res, nil
+	}
+
+	pair, err := startSession(ctx, claims.Id, jwt.AuthLevelMFA, req.Client)
+	if err != nil {
+		logger.Errorf("发生错误：%v", err.Error())
+		res := &user.VerifyTOTPLoginResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：token 创建失败",
+		}
+		return res, nil
+	}
+
+	res := &user.VerifyTOTPLoginResponse{
+		StatusCode:   0,
+		StatusMsg:    "success",
+		UserId:       claims.Id,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}
+	return res, nil
+}
+
+// DisableTOTP implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DisableTOTP(ctx context.Context, req *user.DisableTOTPRequest) (resp *user.DisableTOTPResponse, err error) {
+	logger := zap.InitLogger()
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+
+	totp, err := db.GetUserTOTP(ctx, claims.Id)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证关闭失败",
+		}
+		return res, nil
+	} else if totp == nil || !totp.Enabled() {
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "未开启两步验证",
+		}
+		return res, nil
+	}
+
+	attempt, blocked, _ := reserveLoginAttempt(ctx, mfaLimits(claims.Id))
+	if blocked != nil {
+		res := &user.DisableTOTPResponse{
+			StatusCode: int32(blocked.ErrCode),
+			StatusMsg:  blocked.ErrMsg,
+		}
+		return res, nil
+	}
+	// 验证器丢失时可使用恢复码关闭
+	ok, err := verifyTOTP(ctx, claims.Id, totp, req.Code, true, false)
+	if err != nil {
+		logger.Errorln(err.Error())
+		attempt.cancel(ctx)
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证关闭失败",
+		}
+		return res, nil
+	} else if !ok {
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "验证码错误",
+		}
+		if blocked, _ := attempt.fail(); blocked != nil {
+			res.StatusCode, res.StatusMsg = int32(blocked.ErrCode), blocked.ErrMsg
+		}
+		return res, nil
+	}
+	attempt.succeed(ctx)
+
+	if err := db.DeleteUserTOTP(ctx, claims.Id); err != nil {
+		logger.Errorln(err.Error())
+		res := &user.DisableTOTPResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：两步验证关闭失败",
+		}
+		return res, nil
+	}
+
+	res := &user.DisableTOTPResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// scheduleTime 校验定时发布时间，需晚于当前时间且不超过可提前设置的上限，失败时返回错误描述
+func scheduleTime(publishAt int64) (*time.Time, string) {
+	at := time.UnixMilli(publishAt)
+	now := time.Now()
+	if !at.After(now) {
+		return nil, "定时发布时间需晚于当前时间"
+	}
+	if maxAhead := config.Viper.GetDuration("video.schedule.maxAhead"); at.After(now.Add(maxAhead)) {
+		return nil, fmt.Sprintf("定时发布时间不能晚于%d天后", int(maxAhead.Hours()/24))
+	}
+	return &at, ""
+}
+
+// publishSchedule 根据发布时的草稿及定时发布参数确定视频的发布状态及计划的发布时间，失败时返回错误描述
+func publishSchedule(draft bool, publishAt int64) (uint, *time.Time, string) {
+	if publishAt == 0 {
+		if draft {
+			return db.PublishStatusDraft, nil, ""
+		}
+		return db.PublishStatusPublished, nil, ""
+	}
+	if draft {
+		return 0, nil, "草稿不能同时设置定时发布时间"
+	}
+	at, msg := scheduleTime(publishAt)
+	if msg != "" {
+		return 0, nil, msg
+	}
+	return db.PublishStatusScheduled, at, ""
+}
+
+// publishDueVideosJob 定时发布已到达发布时间的视频
+func publishDueVideosJob() {
+	logger := zap.InitLogger()
+	n, err := db.PublishDueVideos(context.Background(), time.Now(), config.Viper.GetInt("video.schedule.batchSize"))
+	if err != nil {
+		logger.Errorf("定时发布失败：%v", err.Error())
+	}
+	if n > 0 {
+		logger.Infof("定时发布视频 %d 条", n)
+	}
+}
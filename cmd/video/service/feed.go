@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+)
+
+const (
+	feedTypeAll       = 0 // 全部视频
+	feedTypeFollowing = 1 // 关注的人发布的视频
+	feedTypeFriends   = 2 // 好友（互相关注）发布的视频
+)
+
+// feedAuthorIDs 获取 Feed 视频范围对应的作者id列表，全部视频时返回 nil 表示不限制作者
+func feedAuthorIDs(ctx context.Context, feedType int32, viewerID int64) ([]int64, error) {
+	var (
+		relations []*db.FollowRelation
+		err       error
+	)
+	switch feedType {
+	case feedTypeFollowing:
+		relations, err = db.GetFollowingListByUserID(ctx, viewerID)
+	case feedTypeFriends:
+		relations, err = db.GetFriendList(ctx, viewerID)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	authorIDs := make([]int64, 0, len(relations))
+	for _, r := range relations {
+		authorIDs = append(authorIDs, int64(r.ToUserID))
+	}
+	return authorIDs, nil
+}
+
+// videoCursor 获取视频列表中最早发布的视频所在的位置，作为下一页的游标。
+// 列表不足一页时说明没有更多视频，返回 nil
+func videoCursor(videos []*db.Video, pageSize int) *cursor.Cursor {
+	if len(videos) < pageSize {
+		return nil
+	}
+	var c *cursor.Cursor
+	for _, v := range videos {
+		t := v.CreatedAt.UnixMilli()
+		if c == nil || t < c.CreatedAt || (t == c.CreatedAt && int64(v.ID) < c.ID) {
+			c = &cursor.Cursor{CreatedAt: t, ID: int64(v.ID)}
+		}
+	}
+	return c
+}
@@ -1,13 +1,23 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"image"
+	"math"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
-	user "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/redis"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/assembler"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
 	video "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/video"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
@@ -37,109 +47,78 @@ func (s *VideoServiceImpl) Feed(ctx context.Context, req *video.FeedRequest) (re
 		}
 		userID = claims.Id
 	}
-	// 调用数据库查询 video_list
-	videos, err := db.MGetVideos(ctx, limit, &req.LatestTime)
-	if err != nil {
-		logger.Errorln(err.Error())
+	if req.FeedType != feedTypeAll && userID <= 0 {
 		res := &video.FeedResponse{
 			StatusCode: -1,
-			StatusMsg:  "视频获取失败：服务器内部错误",
+			StatusMsg:  "请先登录",
 		}
 		return res, nil
 	}
-	videoList := make([]*video.Video, 0)
-	for _, r := range videos {
-		author, err := db.GetUserByID(ctx, int64(r.AuthorID))
-		if err != nil {
-			logger.Errorf("error:%v", err.Error())
-			return nil, err
-		}
-		relation, err := db.GetRelationByUserIDs(ctx, userID, int64(author.ID))
-		if err != nil {
-			logger.Errorln(err.Error())
+	// 优先使用游标分页，未传入游标时兼容 latest_time
+	cur := cursor.FromTime(req.LatestTime)
+	if req.Cursor != "" {
+		if cur, err = cursor.Decode(req.Cursor); err != nil {
+			logger.Errorf("cursor 解析错误：%s", req.Cursor)
 			res := &video.FeedResponse{
 				StatusCode: -1,
-				StatusMsg:  "视频获取失败：服务器内部错误",
+				StatusMsg:  "cursor 不合法",
 			}
 			return res, nil
 		}
-		favorite, err := db.GetFavoriteVideoRelationByUserVideoID(ctx, userID, int64(r.ID))
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.FeedResponse{
-				StatusCode: -1,
-				StatusMsg:  "视频获取失败：服务器内部错误",
-			}
-			return res, nil
+	}
+	authorIDs, err := feedAuthorIDs(ctx, req.FeedType, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.FeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频获取失败：服务器内部错误",
 		}
-		playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, r.PlayUrl)
-		if err != nil {
-			logger.Errorf("Minio获取链接失败：%v", err.Error())
-			res := &video.FeedResponse{
-				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：视频获取失败",
-			}
-			return res, nil
+		return res, nil
+	}
+
+	// 调用数据库查询 video_list，推荐模式出错时退回按时间倒序
+	var (
+		videos     []*db.Video
+		nextCursor *cursor.Cursor
+	)
+	if req.FeedMode == feedModeRecommend {
+		if videos, nextCursor, err = recommendVideos(ctx, userID, cur, authorIDs); err != nil {
+			logger.Errorf("推荐视频获取失败，退回按时间倒序：%s", err.Error())
 		}
-		coverUrl, err := minio.GetFileTemporaryURL(minio.CoverBucketName, r.CoverUrl)
-		if err != nil {
-			logger.Errorf("Minio获取链接失败：%v", err.Error())
-			res := &video.FeedResponse{
-				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：封面获取失败",
-			}
-			return res, nil
+	}
+	if req.FeedMode != feedModeRecommend || err != nil {
+		if videos, err = db.MGetVideos(ctx, limit, cur, authorIDs, userID); err == nil {
+			nextCursor = videoCursor(videos, limit)
 		}
-		avatarUrl, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, author.Avatar)
-		if err != nil {
-			logger.Errorf("Minio获取链接失败：%v", err.Error())
-			res := &video.FeedResponse{
-				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：头像获取失败",
-			}
-			return res, nil
+	}
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.FeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频获取失败：服务器内部错误",
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, author.BackgroundImage)
-		if err != nil {
-			logger.Errorf("Minio获取链接失败：%v", err.Error())
-			res := &video.FeedResponse{
-				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：背景图获取失败",
-			}
-			return res, nil
+		return res, nil
+	}
+	videoList, err := assembler.Videos(ctx, videos, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.FeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	for _, v := range videos {
+		if t := v.CreatedAt.UnixMilli(); t < nextTime {
+			nextTime = t
 		}
-
-		videoList = append(videoList, &video.Video{
-			Id: int64(r.ID),
-			Author: &user.User{
-				Id:              int64(author.ID),
-				Name:            author.UserName,
-				FollowCount:     int64(author.FollowingCount),
-				FollowerCount:   int64(author.FollowerCount),
-				IsFollow:        relation != nil,
-				Avatar:          avatarUrl,
-				BackgroundImage: backgroundUrl,
-				Signature:       author.Signature,
-				TotalFavorited:  int64(author.TotalFavorited),
-				WorkCount:       int64(author.WorkCount),
-				FavoriteCount:   int64(author.FavoriteCount),
-			},
-			PlayUrl:       playUrl,
-			CoverUrl:      coverUrl,
-			FavoriteCount: int64(r.FavoriteCount),
-			CommentCount:  int64(r.CommentCount),
-			IsFavorite:    favorite != nil,
-			Title:         r.Title,
-		})
-	}
-	if len(videos) != 0 {
-		nextTime = videos[len(videos)-1].UpdatedAt.UnixMilli()
 	}
 	res := &video.FeedResponse{
 		StatusCode: 0,
 		StatusMsg:  "success",
 		VideoList:  videoList,
 		NextTime:   nextTime,
+		NextCursor: cursor.Encode(nextCursor),
 	}
 	return res, nil
 }
@@ -167,6 +146,22 @@ func (s *VideoServiceImpl) PublishAction(ctx context.Context, req *video.Publish
 		}
 		return res, nil
 	}
+	visibility, ok := publishVisibility(req.Visibility)
+	if !ok {
+		res := &video.PublishActionResponse{
+			StatusCode: -1,
+			StatusMsg:  "visibility 不合法",
+		}
+		return res, nil
+	}
+	publishStatus, publishAt, msg := publishSchedule(req.Draft, req.PublishAt)
+	if msg != "" {
+		res := &video.PublishActionResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
 
 	// 限制文件上传大小
 	maxSize := viper.Init("video").Viper.GetInt("video.maxSizeLimit")
@@ -174,23 +169,73 @@ func (s *VideoServiceImpl) PublishAction(ctx context.Context, req *video.Publish
 	if size > maxSize*1000*1000 {
 		logger.Errorln("视频文件过大")
 		res := &video.PublishActionResponse{
-			StatusCode: -1,
+			StatusCode: int32(errno.ErrVideoSizeExceeded.ErrCode),
 			StatusMsg:  fmt.Sprintf("该视频文件大于%dMB，上传受限", maxSize),
 		}
 		return res, nil
 	}
 
-	createTimestamp := time.Now().UnixMilli()
-	videoTitle, coverTitle := fmt.Sprintf("%d_%s_%d.mp4", userID, req.Title, createTimestamp), fmt.Sprintf("%d_%s_%d.png", userID, req.Title, createTimestamp)
+	// 探测并校验视频内容
+	meta, err := probeVideoData(req.Data)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishActionResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频发布失败，服务器内部错误",
+		}
+		if e := (errno.ErrNo{}); errors.As(err, &e) {
+			res.StatusCode, res.StatusMsg = int32(e.ErrCode), e.ErrMsg
+		}
+		return res, nil
+	}
+	format, _ := matchVideoFormat(meta)
+
+	// 校验自定义封面
+	var cover image.Image
+	if len(req.Cover) > 0 {
+		if cover, err = decodeCover(req.Cover); err != nil {
+			logger.Errorln(err.Error())
+			e := errno.ConvertErr(err)
+			res := &video.PublishActionResponse{
+				StatusCode: int32(e.ErrCode),
+				StatusMsg:  e.ErrMsg,
+			}
+			return res, nil
+		}
+	}
+
+	objectKey := tool.NewObjectKey(userID)
+	videoTitle, coverTitle := objectKey+format.ext, objectKey+".jpg"
 
-	// 插入数据库
+	// 自定义封面同步上传，后台处理时不再自动选取
+	var coverThumbs string
+	if cover != nil {
+		if coverThumbs, err = uploadCoverImage(cover, coverTitle); err != nil {
+			res := &video.PublishActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "视频发布失败：封面上传失败",
+			}
+			return res, nil
+		}
+	}
+
+	// 插入数据库，视频在后台处理完成前对外不可见
 	v := &db.Video{
 		Title:    req.Title,
 		PlayUrl:  videoTitle,
 		CoverUrl: coverTitle,
 		AuthorID: uint(userID),
+		Duration: meta.Duration,
+		Width:    meta.Width,
+		Height:   meta.Height,
+		Codec:    meta.VideoCodec,
+		// 自定义封面在发布时已生成缩略图
+		CoverThumbs:   coverThumbs,
+		Visibility:    visibility,
+		PublishStatus: publishStatus,
+		PublishAt:     publishAt,
 	}
-	err = db.CreateVideo(ctx, v)
+	_, err = db.CreatePublishJob(ctx, v, db.VideoStatusUploading, tool.ExtractHashtags(v.Title))
 	if err != nil {
 		logger.Errorln(err.Error())
 		res := &video.PublishActionResponse{
@@ -199,25 +244,33 @@ func (s *VideoServiceImpl) PublishAction(ctx context.Context, req *video.Publish
 		}
 		return res, nil
 	}
+	videoID := int64(v.ID)
 
-	go func() {
-		err := VideoPublish(req.Data, videoTitle, coverTitle)
-		if err != nil {
-			// 发生错误，则删除插入的记录
-			e := db.DelVideoByID(ctx, int64(v.ID), userID)
-			if e != nil {
-				logger.Errorf("视频记录删除失败：%s", err.Error())
-			}
+	// 原始视频同步上传，确保任务入队时视频文件已持久化
+	_, err = uploadVideo(req.Data, videoTitle, format.contentType)
+	if err != nil {
+		if e := db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusFailed, "视频上传失败"); e != nil {
+			logger.Errorf("处理状态更新失败：%s", e.Error())
 		}
-	}()
-	//async.Exec(func() interface{} {
-	//	return VideoPublish(ctx, req.Data, videoTitle, coverTitle, int64(v.ID), userID)
-	//})
-	//future.Await()
+		res := &video.PublishActionResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频发布失败：视频上传失败",
+			VideoId:    videoID,
+		}
+		return res, nil
+	}
+	if err = db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusPending, ""); err != nil {
+		logger.Errorf("处理状态更新失败：%s", err.Error())
+	}
+	// 入队失败时任务仍处于等待状态，由定时任务兜底重新投递
+	if err = enqueuePublishJob(ctx, videoID); err != nil {
+		logger.Errorf("视频 %d 处理任务投递失败：%s", videoID, err.Error())
+	}
 
 	res := &video.PublishActionResponse{
 		StatusCode: 0,
-		StatusMsg:  "创建记录成功，等待后台上传完成",
+		StatusMsg:  "视频上传成功，等待后台处理完成",
+		VideoId:    videoID,
 	}
 	return res, nil
 }
@@ -226,8 +279,31 @@ func (s *VideoServiceImpl) PublishAction(ctx context.Context, req *video.Publish
 func (s *VideoServiceImpl) PublishList(ctx context.Context, req *video.PublishListRequest) (resp *video.PublishListResponse, err error) {
 	logger := zap.InitLogger()
 	userID := req.UserId
+	var viewerID int64 = -1
 
-	results, err := db.GetVideosByUserID(ctx, userID)
+	// 验证token有效性，登录用户可查看对其可见的非公开视频
+	if req.Token != "" {
+		claims, err := Jwt.ParseToken(req.Token)
+		if err != nil {
+			logger.Errorln(err.Error())
+			res := &video.PublishListResponse{
+				StatusCode: -1,
+				StatusMsg:  "token 解析错误",
+			}
+			return res, nil
+		}
+		viewerID = claims.Id
+	}
+	cur, err := cursor.Decode(req.Cursor)
+	if err != nil {
+		logger.Errorf("cursor 解析错误：%s", req.Cursor)
+		res := &video.PublishListResponse{
+			StatusCode: -1,
+			StatusMsg:  "cursor 不合法",
+		}
+		return res, nil
+	}
+	results, err := db.GetVideosByUserID(ctx, userID, viewerID, limit, cur)
 	if err != nil {
 		logger.Errorln(err.Error())
 		res := &video.PublishListResponse{
@@ -236,100 +312,1094 @@ func (s *VideoServiceImpl) PublishList(ctx context.Context, req *video.PublishLi
 		}
 		return res, nil
 	}
-	videos := make([]*video.Video, 0)
-	for _, r := range results {
-		author, err := db.GetUserByID(ctx, int64(r.AuthorID))
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
-				StatusCode: -1,
-				StatusMsg:  "发布列表获取失败：服务器内部错误",
-			}
-			return res, nil
+	videos, err := assembler.Videos(ctx, results, viewerID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishListResponse{
+			StatusCode: -1,
+			StatusMsg:  "发布列表获取失败：服务器内部错误",
 		}
-		follow, err := db.GetRelationByUserIDs(ctx, userID, int64(author.ID))
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
-				StatusCode: -1,
-				StatusMsg:  "发布列表获取失败：服务器内部错误",
-			}
-			return res, nil
+		return res, nil
+	}
+
+	res := &video.PublishListResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		VideoList:  videos,
+		NextCursor: cursor.Encode(videoCursor(results, limit)),
+	}
+	return res, nil
+}
+
+// getUploadSession 获取属于该用户且处于 statuses 之一的分片上传会话，失败时返回错误描述
+func getUploadSession(ctx context.Context, uploadID string, userID int64, statuses ...uint) (*db.UploadSession, string) {
+	logger := zap.InitLogger()
+
+	session, err := db.GetUploadSessionByUploadID(ctx, uploadID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		return nil, "服务器内部错误：上传会话获取失败"
+	}
+	if session == nil || int64(session.UserID) != userID {
+		logger.Errorf("上传会话不存在：%s", uploadID)
+		return nil, "上传会话不存在"
+	}
+	for _, status := range statuses {
+		if session.Status == status {
+			return session, ""
 		}
-		favorite, err := db.GetFavoriteVideoRelationByUserVideoID(ctx, userID, int64(r.ID))
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
-				StatusCode: -1,
-				StatusMsg:  "发布列表获取失败：服务器内部错误",
-			}
-			return res, nil
+	}
+	logger.Errorf("上传会话已结束：%s", uploadID)
+	return nil, "上传会话已结束"
+}
+
+// UploadInit implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UploadInit(ctx context.Context, req *video.UploadInitRequest) (resp *video.UploadInitResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
 		}
-		playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, r.PlayUrl)
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
+		return res, nil
+	}
+	userID := claims.Id
+
+	// 断点续传：返回已有的上传会话及已上传的分片
+	if req.UploadId != "" {
+		session, msg := getUploadSession(ctx, req.UploadId, userID, db.UploadStatusUploading)
+		if session == nil {
+			res := &video.UploadInitResponse{
 				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：视频获取失败",
+				StatusMsg:  msg,
 			}
 			return res, nil
 		}
-		coverUrl, err := minio.GetFileTemporaryURL(minio.CoverBucketName, r.CoverUrl)
+		parts, err := minio.ListUploadedParts(minio.VideoBucketName, session.ObjectName, session.UploadID)
 		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
+			logger.Errorf("Minio获取已上传分片失败：%v", err.Error())
+			res := &video.UploadInitResponse{
 				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：封面获取失败",
+				StatusMsg:  "服务器内部错误：已上传分片获取失败",
 			}
 			return res, nil
 		}
-		avatarUrl, err := minio.GetFileTemporaryURL(minio.AvatarBucketName, author.Avatar)
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
-				StatusCode: -1,
-				StatusMsg:  "服务器内部错误：发布者头像获取失败",
-			}
-			return res, nil
+		uploadedParts := make([]int32, 0, len(parts))
+		for _, part := range parts {
+			uploadedParts = append(uploadedParts, int32(part.PartNumber))
 		}
-		backgroundUrl, err := minio.GetFileTemporaryURL(minio.BackgroundImageBucketName, author.BackgroundImage)
-		if err != nil {
-			logger.Errorln(err.Error())
-			res := &video.PublishListResponse{
-				StatusCode: -1,
-				StatusMsg:  "背景图获取失败",
-			}
-			return res, nil
+		res := &video.UploadInitResponse{
+			StatusCode:    0,
+			StatusMsg:     "success",
+			UploadId:      session.UploadID,
+			PartSize:      session.PartSize,
+			PartCount:     int32(session.PartCount),
+			UploadedParts: uploadedParts,
+		}
+		return res, nil
+	}
+
+	if len(req.Title) == 0 || len(req.Title) > 32 {
+		logger.Errorf("标题不能为空且不能超过32个字符：%d", len(req.Title))
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  "标题不能为空且不能超过32个字符",
+		}
+		return res, nil
+	}
+	visibility, ok := publishVisibility(req.Visibility)
+	if !ok {
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  "visibility 不合法",
+		}
+		return res, nil
+	}
+	publishStatus, publishAt, msg := publishSchedule(req.Draft, req.PublishAt)
+	if msg != "" {
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
 		}
+		return res, nil
+	}
 
-		videos = append(videos, &video.Video{
-			Id: int64(r.ID),
-			Author: &user.User{
-				Id:              int64(author.ID),
-				Name:            author.UserName,
-				FollowerCount:   int64(author.FollowerCount),
-				FollowCount:     int64(author.FollowingCount),
-				IsFollow:        follow != nil,
-				Avatar:          avatarUrl,
-				BackgroundImage: backgroundUrl,
-				Signature:       author.Signature,
-				TotalFavorited:  int64(author.TotalFavorited),
-				WorkCount:       int64(author.WorkCount),
-				FavoriteCount:   int64(author.FavoriteCount),
-			},
-			PlayUrl:       playUrl,
-			CoverUrl:      coverUrl,
-			FavoriteCount: int64(r.FavoriteCount),
-			CommentCount:  int64(r.CommentCount),
-			IsFavorite:    favorite != nil,
-			Title:         r.Title,
-		})
+	// 限制文件上传大小
+	maxSize := config.Viper.GetInt64("video.upload.maxSizeLimit")
+	if req.FileSize <= 0 || req.FileSize > maxSize*1000*1000 {
+		logger.Errorf("视频文件大小不合法：%d", req.FileSize)
+		res := &video.UploadInitResponse{
+			StatusCode: int32(errno.ErrVideoSizeExceeded.ErrCode),
+			StatusMsg:  fmt.Sprintf("视频文件大小需在0到%dMB之间", maxSize),
+		}
+		return res, nil
 	}
+	partSize := config.Viper.GetInt64("video.upload.partSize") * 1024 * 1024
+	partCount := int((req.FileSize + partSize - 1) / partSize)
 
-	res := &video.PublishListResponse{
-		StatusCode: 0,
-		StatusMsg:  "success",
-		VideoList:  videos,
+	// 对象名及 Content-Type 按声明的格式确定，合并后探测到的格式不符时拒绝发布
+	format, ok := videoFormatByName(req.FileName)
+	if !ok {
+		res := &video.UploadInitResponse{
+			StatusCode: int32(errno.ErrVideoInvalidFormat.ErrCode),
+			StatusMsg:  "不支持的视频格式",
+		}
+		return res, nil
+	}
+	videoTitle := tool.NewObjectKey(userID) + format.ext
+	uploadID, err := minio.NewMultipartUpload(minio.VideoBucketName, videoTitle, format.contentType)
+	if err != nil {
+		logger.Errorf("Minio创建分片上传失败：%v", err.Error())
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：上传会话创建失败",
+		}
+		return res, nil
+	}
+
+	session := &db.UploadSession{
+		UploadID:      uploadID,
+		UserID:        uint(userID),
+		Title:         req.Title,
+		Visibility:    visibility,
+		PublishStatus: publishStatus,
+		PublishAt:     publishAt,
+		ObjectName:    videoTitle,
+		FileSize:      req.FileSize,
+		PartSize:      partSize,
+		PartCount:     partCount,
+		Status:        db.UploadStatusUploading,
+	}
+	if err := db.CreateUploadSession(ctx, session); err != nil {
+		logger.Errorln(err.Error())
+		if e := minio.AbortMultipartUpload(minio.VideoBucketName, videoTitle, uploadID); e != nil {
+			logger.Errorf("Minio取消分片上传失败：%v", e.Error())
+		}
+		res := &video.UploadInitResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：上传会话创建失败",
+		}
+		return res, nil
+	}
+
+	res := &video.UploadInitResponse{
+		StatusCode:    0,
+		StatusMsg:     "success",
+		UploadId:      uploadID,
+		PartSize:      partSize,
+		PartCount:     int32(partCount),
+		UploadedParts: make([]int32, 0),
+	}
+	return res, nil
+}
+
+// UploadPart implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UploadPart(ctx context.Context, req *video.UploadPartRequest) (resp *video.UploadPartResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadPartResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	session, msg := getUploadSession(ctx, req.UploadId, userID, db.UploadStatusUploading)
+	if session == nil {
+		res := &video.UploadPartResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	// 校验分片序号及分片大小，除最后一个分片外均须为 PartSize
+	partNumber := int(req.PartNumber)
+	if partNumber < 1 || partNumber > session.PartCount {
+		logger.Errorf("分片序号不合法：%d", partNumber)
+		res := &video.UploadPartResponse{
+			StatusCode: -1,
+			StatusMsg:  fmt.Sprintf("分片序号需在1到%d之间", session.PartCount),
+		}
+		return res, nil
+	}
+	expectedSize := session.PartSize
+	if partNumber == session.PartCount {
+		expectedSize = session.FileSize - session.PartSize*int64(session.PartCount-1)
+	}
+	if int64(len(req.Data)) != expectedSize {
+		logger.Errorf("分片大小不合法：%d，应为%d", len(req.Data), expectedSize)
+		res := &video.UploadPartResponse{
+			StatusCode: -1,
+			StatusMsg:  fmt.Sprintf("分片大小不合法，应为%d字节", expectedSize),
+		}
+		return res, nil
+	}
+
+	_, err = minio.UploadPart(minio.VideoBucketName, session.ObjectName, session.UploadID, partNumber, bytes.NewReader(req.Data), expectedSize)
+	if err != nil {
+		logger.Errorf("分片上传至minio失败：%v", err.Error())
+		res := &video.UploadPartResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：分片上传失败",
+		}
+		return res, nil
+	}
+
+	res := &video.UploadPartResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		PartNumber: req.PartNumber,
+	}
+	return res, nil
+}
+
+// UploadComplete implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UploadComplete(ctx context.Context, req *video.UploadCompleteRequest) (resp *video.UploadCompleteResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadCompleteResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	session, msg := getUploadSession(ctx, req.UploadId, userID, db.UploadStatusUploading, db.UploadStatusMerging)
+	if session == nil {
+		res := &video.UploadCompleteResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	// 校验自定义封面，与单次上传相同的大小及像素数限制
+	var cover image.Image
+	if len(req.Cover) > 0 {
+		if cover, err = decodeCover(req.Cover); err != nil {
+			logger.Errorln(err.Error())
+			e := errno.ConvertErr(err)
+			res := &video.UploadCompleteResponse{
+				StatusCode: int32(e.ErrCode),
+				StatusMsg:  e.ErrMsg,
+			}
+			return res, nil
+		}
+	}
+
+	// 检查分片是否全部上传，全部上传后会话进入合并中状态，不再接收分片
+	if session.Status == db.UploadStatusUploading {
+		parts, err := minio.ListUploadedParts(minio.VideoBucketName, session.ObjectName, session.UploadID)
+		if err != nil {
+			logger.Errorf("Minio获取已上传分片失败：%v", err.Error())
+			res := &video.UploadCompleteResponse{
+				StatusCode: -1,
+				StatusMsg:  "服务器内部错误：已上传分片获取失败",
+			}
+			return res, nil
+		}
+		if len(parts) != session.PartCount {
+			logger.Errorf("分片未全部上传：%d/%d", len(parts), session.PartCount)
+			res := &video.UploadCompleteResponse{
+				StatusCode: -1,
+				StatusMsg:  fmt.Sprintf("分片未全部上传：已上传%d/%d", len(parts), session.PartCount),
+			}
+			return res, nil
+		}
+		if ok, err := db.UpdateUploadSessionStatus(ctx, session.UploadID, db.UploadStatusUploading, db.UploadStatusMerging); err != nil || !ok {
+			logger.Errorf("上传会话状态更新失败：%s", session.UploadID)
+			res := &video.UploadCompleteResponse{
+				StatusCode: -1,
+				StatusMsg:  "上传会话已结束",
+			}
+			return res, nil
+		}
+	}
+
+	// 合并分片，重试时对象已存在则跳过
+	if _, err := minio.StatFile(minio.VideoBucketName, session.ObjectName); err != nil {
+		size, err := minio.CompleteMultipartUpload(minio.VideoBucketName, session.ObjectName, session.UploadID)
+		if err != nil {
+			logger.Errorf("Minio合并分片失败：%v", err.Error())
+			res := &video.UploadCompleteResponse{
+				StatusCode: -1,
+				StatusMsg:  "服务器内部错误：分片合并失败，请重试",
+			}
+			return res, nil
+		}
+		logger.Infof("视频文件大小为：%v", size)
+	}
+
+	// 探测视频内容，探测失败时保留会话以便重试，取消上传时清理对象
+	playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, session.ObjectName)
+	if err != nil {
+		logger.Errorf("Minio获取链接失败：%v", err.Error())
+		res := &video.UploadCompleteResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：视频获取失败，请重试",
+		}
+		return res, nil
+	}
+	meta, err := tool.ProbeVideo(playUrl)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadCompleteResponse{
+			StatusCode: int32(errno.ErrVideoInvalidFormat.ErrCode),
+			StatusMsg:  "视频解析失败，请重试或取消上传",
+		}
+		return res, nil
+	}
+	// 不符合要求的视频直接删除并结束会话
+	rejectUpload := func(e errno.ErrNo) (*video.UploadCompleteResponse, error) {
+		if _, err := db.UpdateUploadSessionStatus(ctx, session.UploadID, db.UploadStatusMerging, db.UploadStatusAborted); err != nil {
+			logger.Errorf("上传会话状态更新失败：%s", session.UploadID)
+		}
+		if err := minio.RemoveFile(minio.VideoBucketName, session.ObjectName); err != nil {
+			logger.Errorf("Minio删除视频失败：%v", err.Error())
+		}
+		res := &video.UploadCompleteResponse{
+			StatusCode: int32(e.ErrCode),
+			StatusMsg:  e.ErrMsg,
+		}
+		return res, nil
+	}
+	if err := validateVideo(meta); err != nil {
+		logger.Errorln(err.Error())
+		return rejectUpload(errno.ConvertErr(err))
+	}
+
+	// 探测到的格式须与上传会话声明的格式一致，对象的扩展名及 Content-Type 在创建时已确定
+	if format, ok := videoFormatByName(session.ObjectName); !ok || !format.probedAs(meta) {
+		logger.Errorf("视频格式与声明的格式不符：%s %v", session.ObjectName, meta.Formats)
+		return rejectUpload(errno.ErrVideoInvalidFormat.WithMessage("视频格式与文件扩展名不符"))
+	}
+	videoTitle := session.ObjectName
+	objectKey := strings.TrimSuffix(videoTitle, path.Ext(videoTitle))
+
+	// 自定义封面同步上传，后台处理时不再自动选取
+	coverTitle := objectKey + ".jpg"
+	var coverThumbs string
+	if cover != nil {
+		if coverThumbs, err = uploadCoverImage(cover, coverTitle); err != nil {
+			res := &video.UploadCompleteResponse{
+				StatusCode: -1,
+				StatusMsg:  "视频发布失败：封面上传失败，请重试",
+			}
+			return res, nil
+		}
+	}
+
+	// 插入数据库
+	v := &db.Video{
+		Title:    session.Title,
+		PlayUrl:  videoTitle,
+		CoverUrl: coverTitle,
+		AuthorID: session.UserID,
+		Duration: meta.Duration,
+		Width:    meta.Width,
+		Height:   meta.Height,
+		Codec:    meta.VideoCodec,
+		// 自定义封面在合并完成时已生成缩略图
+		CoverThumbs: coverThumbs,
+		Visibility:  session.Visibility,
+		// 定时发布时间在合并完成时可能已过，由定时任务尽快发布
+		PublishStatus: session.PublishStatus,
+		PublishAt:     session.PublishAt,
+	}
+	// 创建视频记录的同时结束会话，失败时会话仍处于合并中，可以重试
+	job, err := db.CompleteUploadSession(ctx, session.UploadID, v, db.VideoStatusPending, tool.ExtractHashtags(v.Title))
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadCompleteResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频发布失败，服务器内部错误，请重试",
+		}
+		return res, nil
+	} else if job == nil {
+		logger.Errorf("上传会话已结束：%s", session.UploadID)
+		res := &video.UploadCompleteResponse{
+			StatusCode: -1,
+			StatusMsg:  "上传会话已结束",
+		}
+		return res, nil
+	}
+	videoID := int64(v.ID)
+	// 入队失败时任务仍处于等待状态，由定时任务兜底重新投递
+	if err = enqueuePublishJob(ctx, videoID); err != nil {
+		logger.Errorf("视频 %d 处理任务投递失败：%s", videoID, err.Error())
+	}
+
+	res := &video.UploadCompleteResponse{
+		StatusCode: 0,
+		StatusMsg:  "视频上传完成，等待后台处理完成",
+		VideoId:    videoID,
+	}
+	return res, nil
+}
+
+// UploadAbort implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UploadAbort(ctx context.Context, req *video.UploadAbortRequest) (resp *video.UploadAbortResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UploadAbortResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	session, msg := getUploadSession(ctx, req.UploadId, userID, db.UploadStatusUploading, db.UploadStatusMerging)
+	if session == nil {
+		res := &video.UploadAbortResponse{
+			StatusCode: -1,
+			StatusMsg:  msg,
+		}
+		return res, nil
+	}
+
+	if ok, err := db.UpdateUploadSessionStatus(ctx, session.UploadID, session.Status, db.UploadStatusAborted); err != nil || !ok {
+		logger.Errorf("上传会话状态更新失败：%s", session.UploadID)
+		res := &video.UploadAbortResponse{
+			StatusCode: -1,
+			StatusMsg:  "上传会话已结束",
+		}
+		return res, nil
+	}
+	err = minio.AbortMultipartUpload(minio.VideoBucketName, session.ObjectName, session.UploadID)
+	if session.Status == db.UploadStatusMerging {
+		// 合并中的会话分片可能已合并为对象，此时分片上传会话已不存在，删除合并后的对象
+		if err != nil {
+			logger.Infof("Minio取消分片上传失败，删除已合并的视频：%v", err.Error())
+		}
+		err = minio.RemoveFile(minio.VideoBucketName, session.ObjectName)
+	}
+	if err != nil {
+		logger.Errorf("Minio取消分片上传失败：%v", err.Error())
+		res := &video.UploadAbortResponse{
+			StatusCode: -1,
+			StatusMsg:  "服务器内部错误：上传取消失败",
+		}
+		return res, nil
+	}
+
+	res := &video.UploadAbortResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
+
+// PublishStatus implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) PublishStatus(ctx context.Context, req *video.PublishStatusRequest) (resp *video.PublishStatusResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishStatusResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	v, err := db.GetVideoById(ctx, req.VideoId)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishStatusResponse{
+			StatusCode: -1,
+			StatusMsg:  "处理进度获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	// 仅作者本人可以查询处理进度
+	if v == nil || int64(v.AuthorID) != userID {
+		res := &video.PublishStatusResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频不存在",
+		}
+		return res, nil
+	}
+
+	job, err := db.GetPublishJobByVideoID(ctx, req.VideoId)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishStatusResponse{
+			StatusCode: -1,
+			StatusMsg:  "处理进度获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	res := &video.PublishStatusResponse{
+		StatusCode:    0,
+		StatusMsg:     "success",
+		VideoId:       req.VideoId,
+		ProcessStatus: int32(v.ProcessStatus),
+	}
+	// 处理任务上线前发布的视频没有对应任务记录
+	if job != nil {
+		res.Retries = int32(job.Retries)
+		if job.Status == db.VideoStatusFailed {
+			res.FailReason = job.ErrMsg
+		}
+	}
+	return res, nil
+}
+
+// DeleteVideo implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) DeleteVideo(ctx context.Context, req *video.DeleteVideoRequest) (resp *video.DeleteVideoResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.DeleteVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	// 仅作者本人可以删除视频
+	v, err := db.DelVideoByID(ctx, req.VideoId, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.DeleteVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频删除失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if v == nil {
+		res := &video.DeleteVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频不存在",
+		}
+		return res, nil
+	}
+
+	// 数据库记录已删除，对象存储清理失败仅记录日志
+	go func() {
+		if err := removeVideoObjects(v); err != nil {
+			logger.Errorf("视频 %d 的存储对象清理失败：%s", v.ID, err.Error())
+		}
+	}()
+
+	res := &video.DeleteVideoResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
+
+// UpdateVideo implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) UpdateVideo(ctx context.Context, req *video.UpdateVideoRequest) (resp *video.UpdateVideoResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	if len(req.Title) == 0 && req.Visibility == 0 {
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "标题与可见范围不能同时为空",
+		}
+		return res, nil
+	}
+	if len(req.Title) > 32 {
+		logger.Errorf("标题不能超过32个字符：%d", len(req.Title))
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "标题不能超过32个字符",
+		}
+		return res, nil
+	}
+	// 可见范围为 0 表示不修改
+	visibility := uint(req.Visibility)
+	if req.Visibility < 0 || (visibility != 0 && !db.ValidVisibility(visibility)) {
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "visibility 不合法",
+		}
+		return res, nil
+	}
+
+	// 仅作者本人可以编辑视频
+	ok, err := db.UpdateVideoInfo(ctx, req.VideoId, userID, req.Title, tool.ExtractHashtags(req.Title), visibility)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频编辑失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if !ok {
+		res := &video.UpdateVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频不存在",
+		}
+		return res, nil
+	}
+
+	res := &video.UpdateVideoResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
+
+// PlayAction implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) PlayAction(ctx context.Context, req *video.PlayActionRequest) (resp *video.PlayActionResponse, err error) {
+	logger := zap.InitLogger()
+	var userID int64
+
+	// 未登录用户同样计入播放
+	if req.Token != "" {
+		claims, err := Jwt.ParseToken(req.Token)
+		if err != nil {
+			logger.Errorln(err.Error())
+			res := &video.PlayActionResponse{
+				StatusCode: -1,
+				StatusMsg:  "token 解析错误",
+			}
+			return res, nil
+		}
+		userID = claims.Id
+	}
+	if req.VideoId <= 0 || req.WatchDuration < 0 || math.IsNaN(req.WatchDuration) || math.IsInf(req.WatchDuration, 0) {
+		res := &video.PlayActionResponse{
+			StatusCode: -1,
+			StatusMsg:  "参数不合法",
+		}
+		return res, nil
+	}
+	// 观看时长不超过视频时长上限，避免异常上报影响统计
+	watchDuration := req.WatchDuration
+	if maxDuration := config.Viper.GetFloat64("video.validation.maxDuration"); watchDuration > maxDuration {
+		watchDuration = maxDuration
+	}
+
+	// 将播放事件存入消息队列，由消费者在 Redis 中聚合后定期同步至数据库，已删除视频的数据在同步时忽略
+	play := &redis.PlayCache{
+		VideoID:       uint(req.VideoId),
+		UserID:        uint(userID),
+		WatchDuration: watchDuration,
+		CreatedAt:     uint(time.Now().UnixMilli()),
+	}
+	if err = enqueuePlay(ctx, play); err != nil {
+		res := &video.PlayActionResponse{
+			StatusCode: -1,
+			StatusMsg:  "播放记录失败：服务器内部错误",
+		}
+		return res, nil
+	}
+
+	res := &video.PlayActionResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+	}
+	return res, nil
+}
+
+// TopicFeed implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) TopicFeed(ctx context.Context, req *video.TopicFeedRequest) (resp *video.TopicFeedResponse, err error) {
+	logger := zap.InitLogger()
+	var userID int64 = -1
+
+	// 验证token有效性
+	if req.Token != "" {
+		claims, err := Jwt.ParseToken(req.Token)
+		if err != nil {
+			logger.Errorln(err.Error())
+			res := &video.TopicFeedResponse{
+				StatusCode: -1,
+				StatusMsg:  "token 解析错误",
+			}
+			return res, nil
+		}
+		userID = claims.Id
+	}
+	cur, err := cursor.Decode(req.Cursor)
+	if err != nil {
+		logger.Errorf("cursor 解析错误：%s", req.Cursor)
+		res := &video.TopicFeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "cursor 不合法",
+		}
+		return res, nil
+	}
+
+	tag, err := db.GetTagByName(ctx, normalizeTag(req.Tag))
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.TopicFeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "话题视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if tag == nil {
+		res := &video.TopicFeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "话题不存在",
+		}
+		return res, nil
+	}
+	videos, err := db.GetVideosByTagID(ctx, int64(tag.ID), userID, limit, cur)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.TopicFeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "话题视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	videoList, err := assembler.Videos(ctx, videos, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.TopicFeedResponse{
+			StatusCode: -1,
+			StatusMsg:  "话题视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+
+	res := &video.TopicFeedResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Tag:        packTag(tag, 0),
+		VideoList:  videoList,
+		NextCursor: cursor.Encode(videoCursor(videos, limit)),
+	}
+	return res, nil
+}
+
+// TrendingTags implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) TrendingTags(ctx context.Context, req *video.TrendingTagsRequest) (resp *video.TrendingTagsResponse, err error) {
+	logger := zap.InitLogger()
+
+	trends, err := getTrendingTags()
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.TrendingTagsResponse{
+			StatusCode: -1,
+			StatusMsg:  "热门话题获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if req.Limit > 0 && int(req.Limit) < len(trends) {
+		trends = trends[:req.Limit]
+	}
+	tags := make([]*video.Tag, 0, len(trends))
+	for _, t := range trends {
+		tags = append(tags, packTag(t.Tag, t.Score))
+	}
+
+	res := &video.TrendingTagsResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Tags:       tags,
+	}
+	return res, nil
+}
+
+// ShareVideo implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ShareVideo(ctx context.Context, req *video.ShareVideoRequest) (resp *video.ShareVideoResponse, err error) {
+	logger := zap.InitLogger()
+
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+	channel := strings.TrimSpace(req.Channel)
+	if req.VideoId <= 0 || len(channel) > maxShareChannelLength {
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "参数不合法",
+		}
+		return res, nil
+	}
+	// 仅能分享自己可以查看的视频
+	v, err := db.GetVideoById(ctx, req.VideoId)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	visible := false
+	if v != nil {
+		if visible, err = db.CanViewVideo(ctx, v, userID); err != nil {
+			logger.Errorln(err.Error())
+			res := &video.ShareVideoResponse{
+				StatusCode: -1,
+				StatusMsg:  "分享失败：服务器内部错误",
+			}
+			return res, nil
+		}
+	}
+	if !visible {
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频不存在",
+		}
+		return res, nil
+	}
+
+	// 同一用户在窗口期内重复分享只计一次，去重失败时不累加分享数，分享本身不受影响
+	counted, err := redis.MarkVideoShared(ctx, req.VideoId, userID, config.Viper.GetDuration("video.share.dedupWindow"))
+	if err != nil {
+		logger.Errorf("分享去重失败：%v", err.Error())
+	}
+	v, err = db.CreateVideoShare(ctx, &db.VideoShare{
+		VideoID: uint(req.VideoId),
+		UserID:  uint(userID),
+		Channel: channel,
+	}, counted)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if v == nil {
+		res := &video.ShareVideoResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频不存在",
+		}
+		return res, nil
+	}
+
+	code := shareCode(req.VideoId, userID)
+	res := &video.ShareVideoResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		ShareCode:  code,
+		ShareUrl:   shareURL(code),
+		ShareCount: int64(v.ShareCount),
+	}
+	return res, nil
+}
+
+// ResolveShare implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ResolveShare(ctx context.Context, req *video.ResolveShareRequest) (resp *video.ResolveShareResponse, err error) {
+	logger := zap.InitLogger()
+	var userID int64 = -1
+
+	// 分享链接无需登录即可访问，登录用户可查看对其可见的非公开视频
+	if req.Token != "" {
+		claims, err := Jwt.ParseToken(req.Token)
+		if err != nil {
+			logger.Errorln(err.Error())
+			res := &video.ResolveShareResponse{
+				StatusCode: -1,
+				StatusMsg:  "token 解析错误",
+			}
+			return res, nil
+		}
+		userID = claims.Id
+	}
+	// 签名校验失败的分享码视为不存在
+	videoID, sharerID, err := parseShareCode(req.ShareCode)
+	if err != nil {
+		logger.Errorf("分享码解析错误：%s", req.ShareCode)
+		res := &video.ResolveShareResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享链接无效",
+		}
+		return res, nil
+	}
+	v, err := db.GetVideoById(ctx, videoID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.ResolveShareResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	visible := false
+	if v != nil && v.ProcessStatus == db.VideoStatusReady {
+		if visible, err = db.CanViewVideo(ctx, v, userID); err != nil {
+			logger.Errorln(err.Error())
+			res := &video.ResolveShareResponse{
+				StatusCode: -1,
+				StatusMsg:  "分享视频获取失败：服务器内部错误",
+			}
+			return res, nil
+		}
+	}
+	if !visible {
+		res := &video.ResolveShareResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享的视频不存在",
+		}
+		return res, nil
+	}
+	videoList, err := assembler.Videos(ctx, []*db.Video{v}, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.ResolveShareResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享视频获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if len(videoList) == 0 {
+		res := &video.ResolveShareResponse{
+			StatusCode: -1,
+			StatusMsg:  "分享的视频不存在",
+		}
+		return res, nil
+	}
+
+	res := &video.ResolveShareResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		Video:      videoList[0],
+		SharerId:   sharerID,
+	}
+	return res, nil
+}
+
+// DraftList implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) DraftList(ctx context.Context, req *video.DraftListRequest) (resp *video.DraftListResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.DraftListResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+	cur, err := cursor.Decode(req.Cursor)
+	if err != nil {
+		logger.Errorf("cursor 解析错误：%s", req.Cursor)
+		res := &video.DraftListResponse{
+			StatusCode: -1,
+			StatusMsg:  "cursor 不合法",
+		}
+		return res, nil
+	}
+
+	// 草稿列表仅作者本人可以查看
+	results, err := db.GetDraftVideosByUserID(ctx, userID, limit, cur)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.DraftListResponse{
+			StatusCode: -1,
+			StatusMsg:  "草稿列表获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	videos, err := assembler.Videos(ctx, results, userID)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.DraftListResponse{
+			StatusCode: -1,
+			StatusMsg:  "草稿列表获取失败：服务器内部错误",
+		}
+		return res, nil
+	}
+
+	res := &video.DraftListResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
+		VideoList:  videos,
+		NextCursor: cursor.Encode(videoCursor(results, limit)),
+	}
+	return res, nil
+}
+
+// PublishDraft implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) PublishDraft(ctx context.Context, req *video.PublishDraftRequest) (resp *video.PublishDraftResponse, err error) {
+	logger := zap.InitLogger()
+	// 解析token,获取用户id
+	claims, err := Jwt.ParseToken(req.Token)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishDraftResponse{
+			StatusCode: -1,
+			StatusMsg:  "token 解析错误",
+		}
+		return res, nil
+	}
+	userID := claims.Id
+
+	// 不指定发布时间时立即发布
+	var publishAt *time.Time
+	if req.PublishAt != 0 {
+		var msg string
+		if publishAt, msg = scheduleTime(req.PublishAt); msg != "" {
+			res := &video.PublishDraftResponse{
+				StatusCode: -1,
+				StatusMsg:  msg,
+			}
+			return res, nil
+		}
+	}
+	ok, err := db.ScheduleVideoPublish(ctx, req.VideoId, userID, publishAt)
+	if err != nil {
+		logger.Errorln(err.Error())
+		res := &video.PublishDraftResponse{
+			StatusCode: -1,
+			StatusMsg:  "视频发布失败：服务器内部错误",
+		}
+		return res, nil
+	}
+	if !ok {
+		res := &video.PublishDraftResponse{
+			StatusCode: -1,
+			StatusMsg:  "草稿不存在",
+		}
+		return res, nil
+	}
+
+	res := &video.PublishDraftResponse{
+		StatusCode: 0,
+		StatusMsg:  "success",
 	}
 	return res, nil
 }
@@ -1,13 +1,46 @@
 package service
 
 import (
+	"fmt"
+
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/jwt"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/rabbitmq"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+const (
+	publishQueue           = "video_publish"
+	publishDeadLetterQueue = "video_publish_dead_letter"
+	playQueue              = "video_play"
 )
 
 var (
-	Jwt *jwt.JWT
+	Jwt    *jwt.JWT
+	config = viper.Init("video")
+	// PublishMq 视频处理任务队列，手动应答，失败超过重试上限的任务转入死信队列
+	PublishMq = rabbitmq.NewRabbitMQDeadLetter(publishQueue, publishDeadLetterQueue, false)
+	// PlayMq 播放事件队列，消费后在 Redis 中聚合，定期同步至数据库
+	PlayMq = rabbitmq.NewRabbitMQSimple(playQueue, false)
 )
 
-func Init(signingKey string) {
-	Jwt = jwt.NewJWT([]byte(signingKey))
+func Init(keyConfig jwt.KeyConfig) {
+	Jwt = jwt.MustNewJWTWithKeys(keyConfig)
+	Jwt.Revoker = jwt.NewRedisRevoker()
+	var err error
+	if shareSecret, err = loadShareSecret(config.Viper.GetString("video.share.secretFile")); err != nil {
+		panic(fmt.Errorf("load share secret: %w, run scripts/share-key.sh to generate the secret", err))
+	}
+	if err = minio.CreateBucket(minio.VideoBucketName); err != nil {
+		panic(err)
+	}
+	// HLS 文件经 API 服务的签名链接访问，取消旧版本设置的匿名读取策略，失败不影响启动
+	if err = minio.RemoveBucketPublicReadPrefix(minio.VideoBucketName, config.Viper.GetString("video.hls.prefix")); err != nil {
+		zap.InitLogger().Errorf("取消 HLS 文件的匿名读取失败：%s", err.Error())
+	}
+	registerRankers()
+	GoCron()
+	go consume()
+	go consumePlay()
 }
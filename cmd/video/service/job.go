@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/gocron"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/rabbitmq"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const sweepFrequency = 60
+
+// PublishJobMessage 视频处理任务消息
+type PublishJobMessage struct {
+	VideoID int64 `json:"video_id"`
+}
+
+// enqueuePublishJob 投递视频处理任务，投递失败的任务由定时任务兜底重新投递
+func enqueuePublishJob(ctx context.Context, videoID int64) error {
+	logger := zap.InitLogger()
+
+	body, err := json.Marshal(&PublishJobMessage{VideoID: videoID})
+	if err != nil {
+		return err
+	}
+	if err = PublishMq.PublishSimple(ctx, body); err != nil {
+		logger.Errorf("消息队列发布错误：%v", err.Error())
+		if strings.Contains(err.Error(), "连接断开") {
+			// 检测到通道关闭，则重连
+			go PublishMq.Destroy()
+			PublishMq = rabbitmq.NewRabbitMQDeadLetter(publishQueue, publishDeadLetterQueue, false)
+			logger.Errorln("消息队列通道尝试重连：" + publishQueue)
+			go consume()
+		}
+		return err
+	}
+	return nil
+}
+
+// processPublishJob 执行视频处理任务：转码为 HLS 并选取封面，完成后视频对外可见
+func processPublishJob(ctx context.Context, videoID int64) error {
+	job, err := db.GetPublishJobByVideoID(ctx, videoID)
+	if err != nil {
+		return fmt.Errorf("处理任务获取失败：%w", err)
+	}
+	// 任务不存在或已结束，重复投递的消息直接忽略
+	if job == nil || job.Status == db.VideoStatusReady || job.Status == db.VideoStatusFailed {
+		return nil
+	}
+	v := job.Video
+
+	if err = db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusTranscoding, ""); err != nil {
+		return fmt.Errorf("处理状态更新失败：%w", err)
+	}
+	hlsUrl, err := HLSPublish(v.PlayUrl)
+	if err != nil {
+		return fmt.Errorf("视频转码失败：%w", err)
+	}
+	if err = db.UpdateVideoHlsUrl(ctx, videoID, hlsUrl); err != nil {
+		return fmt.Errorf("播放列表更新失败：%w", err)
+	}
+	// 自定义封面在发布时已上传，无需再选取
+	if v.CoverThumbs == "" {
+		thumbs, err := CoverPublish(v.PlayUrl, v.CoverUrl, v.Duration)
+		if err != nil {
+			return fmt.Errorf("封面生成失败：%w", err)
+		}
+		if err = db.UpdateVideoCoverThumbs(ctx, videoID, thumbs); err != nil {
+			return fmt.Errorf("封面信息更新失败：%w", err)
+		}
+	}
+	if err = db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusReady, ""); err != nil {
+		return fmt.Errorf("处理状态更新失败：%w", err)
+	}
+	return nil
+}
+
+// handlePublishJobFailure 处理失败的任务：未超出重试上限则延迟后重新投递，否则标记失败并转入死信队列
+func handlePublishJobFailure(ctx context.Context, msg amqp.Delivery, videoID int64, reason error) error {
+	logger := zap.InitLogger()
+	maxRetries := config.Viper.GetUint("video.job.maxRetries")
+	retryInterval := config.Viper.GetInt("video.job.retryInterval")
+
+	retries, err := db.IncrPublishJobRetries(ctx, videoID, reason.Error())
+	if err != nil {
+		logger.Errorf("处理任务重试次数更新失败：%v", err.Error())
+		// 数据库不可用时重新入队，等待下次消费
+		return msg.Nack(false, true)
+	}
+	if retries > maxRetries {
+		logger.Errorf("视频 %d 处理失败且超出重试上限：%v", videoID, reason.Error())
+		if err = db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusFailed, reason.Error()); err != nil {
+			logger.Errorf("处理状态更新失败：%v", err.Error())
+		}
+		return msg.Nack(false, false)
+	}
+
+	// 退回等待状态，延迟后重新投递
+	if err = db.UpdatePublishJobStatus(ctx, videoID, db.VideoStatusPending, reason.Error()); err != nil {
+		logger.Errorf("处理状态更新失败：%v", err.Error())
+	}
+	time.AfterFunc(time.Duration(retryInterval)*time.Duration(retries)*time.Second, func() {
+		if err := enqueuePublishJob(context.Background(), videoID); err != nil {
+			logger.Errorf("视频 %d 处理任务重新投递失败：%v", videoID, err.Error())
+		}
+	})
+	return msg.Ack(false)
+}
+
+// 视频处理任务队列消费者
+func consume() error {
+	logger := zap.InitLogger()
+
+	msgs, err := PublishMq.ConsumeSimple()
+	if err != nil {
+		logger.Errorf("PublishMQ Err: %s", err.Error())
+		return err
+	}
+	for msg := range msgs {
+		m := new(PublishJobMessage)
+		if err := json.Unmarshal(msg.Body, m); err != nil {
+			logger.Errorf("json unmarshal error: %s", err.Error())
+			// 无法解析的消息直接转入死信队列
+			if err = msg.Nack(false, false); err != nil {
+				logger.Errorf("nack error: %s", err.Error())
+			}
+			continue
+		}
+		ctx := context.Background()
+		if err := processPublishJob(ctx, m.VideoID); err != nil {
+			logger.Errorf("视频 %d 处理失败：%v", m.VideoID, err.Error())
+			if err = handlePublishJobFailure(ctx, msg, m.VideoID, err); err != nil {
+				logger.Errorf("ack error: %s", err.Error())
+			}
+			continue
+		}
+		if err := msg.Ack(false); err != nil {
+			logger.Errorf("ack error: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// sweepPublishJobs 兜底处理长时间停滞的任务：等待中的任务重新投递，上传中断的任务标记失败
+func sweepPublishJobs() {
+	logger := zap.InitLogger()
+	ctx := context.Background()
+	before := time.Now().Add(-time.Duration(config.Viper.GetInt("video.job.staleTimeout")) * time.Second)
+
+	pending, err := db.GetStalePublishJobs(ctx, db.VideoStatusPending, before, limit)
+	if err != nil {
+		logger.Errorf("停滞任务获取失败：%v", err.Error())
+		return
+	}
+	for _, job := range pending {
+		// 刷新更新时间，避免下一轮重复投递
+		if err = db.UpdatePublishJobStatus(ctx, int64(job.VideoID), db.VideoStatusPending, job.ErrMsg); err != nil {
+			logger.Errorf("处理状态更新失败：%v", err.Error())
+			continue
+		}
+		if err = enqueuePublishJob(ctx, int64(job.VideoID)); err != nil {
+			logger.Errorf("视频 %d 处理任务重新投递失败：%v", job.VideoID, err.Error())
+		}
+	}
+
+	uploading, err := db.GetStalePublishJobs(ctx, db.VideoStatusUploading, before, limit)
+	if err != nil {
+		logger.Errorf("停滞任务获取失败：%v", err.Error())
+		return
+	}
+	for _, job := range uploading {
+		if err = db.UpdatePublishJobStatus(ctx, int64(job.VideoID), db.VideoStatusFailed, "视频上传中断"); err != nil {
+			logger.Errorf("处理状态更新失败：%v", err.Error())
+		}
+	}
+}
+
+// GoCron gocron定时任务，定期兜底处理停滞的视频处理任务，发布到期的定时发布视频，并刷新热门话题
+func GoCron() {
+	s := gocron.NewSchedule()
+	s.Every(sweepFrequency).Tag("publishJob").Seconds().Do(sweepPublishJobs)
+	s.Every(config.Viper.GetInt("video.schedule.interval")).Tag("scheduledPublish").Seconds().Do(publishDueVideosJob)
+	s.Every(config.Viper.GetInt("video.topic.refreshInterval")).Tag("trendingTags").Seconds().Do(refreshTrendingTagsJob)
+	s.StartAsync()
+}
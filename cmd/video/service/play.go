@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/redis"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/rabbitmq"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// enqueuePlay 投递播放事件
+func enqueuePlay(ctx context.Context, play *redis.PlayCache) error {
+	logger := zap.InitLogger()
+
+	body, err := json.Marshal(play)
+	if err != nil {
+		return err
+	}
+	if err = PlayMq.PublishSimple(ctx, body); err != nil {
+		logger.Errorf("消息队列发布错误：%v", err.Error())
+		if strings.Contains(err.Error(), "连接断开") {
+			// 检测到通道关闭，则重连
+			go PlayMq.Destroy()
+			PlayMq = rabbitmq.NewRabbitMQSimple(playQueue, false)
+			logger.Errorln("消息队列通道尝试重连：" + playQueue)
+			go consumePlay()
+		}
+		return err
+	}
+	return nil
+}
+
+// 播放事件队列消费者，将播放事件聚合至 Redis
+func consumePlay() error {
+	logger := zap.InitLogger()
+
+	msgs, err := PlayMq.ConsumeSimple()
+	if err != nil {
+		logger.Errorf("PlayMQ Err: %s", err.Error())
+		return err
+	}
+	for msg := range msgs {
+		play := new(redis.PlayCache)
+		if err := json.Unmarshal(msg.Body, play); err != nil {
+			logger.Errorf("json unmarshal error: %s", err.Error())
+			// 无法解析的消息直接丢弃
+			if err = msg.Nack(false, false); err != nil {
+				logger.Errorf("nack error: %s", err.Error())
+			}
+			continue
+		}
+		if err := redis.UpdatePlay(context.Background(), play); err != nil {
+			logger.Errorf("播放事件写入 Redis 失败：%s", err.Error())
+			// Redis 不可用时重新入队，等待下次消费
+			if err = msg.Nack(false, true); err != nil {
+				logger.Errorf("nack error: %s", err.Error())
+			}
+			continue
+		}
+		if err := msg.Ack(false); err != nil {
+			logger.Errorf("ack error: %s", err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/recommend"
+)
+
+const (
+	feedModeLatest    = 0 // 按投稿时间倒序
+	feedModeRecommend = 1 // 个性化推荐
+
+	weightedRanker = "weighted"
+)
+
+// registerRankers 注册内置排序器，权重从配置文件读取
+func registerRankers() {
+	recommend.Register(weightedRanker, recommend.NewWeightedRanker(recommend.Weights{
+		Favorite:  config.Viper.GetFloat64("video.recommend.weights.favorite"),
+		Comment:   config.Viper.GetFloat64("video.recommend.weights.comment"),
+		Following: config.Viper.GetFloat64("video.recommend.weights.following"),
+		Social:    config.Viper.GetFloat64("video.recommend.weights.social"),
+		Seen:      config.Viper.GetFloat64("video.recommend.weights.seen"),
+		HalfLife:  config.Viper.GetDuration("video.recommend.halfLife"),
+	}))
+}
+
+// recommendVideos 从游标所在位置之后最近发布的视频中选取候选窗口，经排序器排序后返回窗口内 Offset 之后的 limit 条。
+// 窗口未取完时，下一页游标保持窗口起点并增加 Offset；取完后从窗口中最早发布的视频之后选取新的窗口；
+// 没有更多视频时返回 nil。翻页期间窗口内的排序可能因互动数据变化而略有变动
+func recommendVideos(ctx context.Context, viewerID int64, c *cursor.Cursor, authorIDs []int64) ([]*db.Video, *cursor.Cursor, error) {
+	name := config.Viper.GetString("video.recommend.ranker")
+	ranker, ok := recommend.Get(name)
+	if !ok {
+		return nil, nil, fmt.Errorf("ranker %q not registered", name)
+	}
+	var (
+		start  *cursor.Cursor
+		offset int
+	)
+	if c != nil {
+		start, offset = &cursor.Cursor{CreatedAt: c.CreatedAt, ID: c.ID}, c.Offset
+	}
+	size := config.Viper.GetInt("video.recommend.candidates")
+	videos, err := db.MGetVideos(ctx, size, start, authorIDs, viewerID)
+	if err != nil || len(videos) == 0 {
+		return videos, nil, err
+	}
+	// 固定窗口起点，翻页期间新发布的视频不会进入本窗口，兼容 latest_time 的游标不含 id，同样改用窗口内最新的视频
+	if start == nil || start.ID <= 0 {
+		start = &cursor.Cursor{CreatedAt: videos[0].CreatedAt.UnixMilli(), ID: int64(videos[0].ID) + 1}
+	}
+
+	candidates, err := recommend.LoadCandidates(ctx, videos, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if candidates, err = ranker.Rank(ctx, viewerID, candidates); err != nil {
+		return nil, nil, err
+	}
+	if offset > len(candidates) {
+		offset = len(candidates)
+	}
+	end := offset + limit
+	if end > len(candidates) {
+		end = len(candidates)
+	}
+	res := make([]*db.Video, 0, end-offset)
+	for _, c := range candidates[offset:end] {
+		res = append(res, c.Video)
+	}
+
+	var next *cursor.Cursor
+	if end < len(candidates) {
+		next = &cursor.Cursor{CreatedAt: start.CreatedAt, ID: start.ID, Offset: end}
+	} else if len(videos) == size {
+		last := videos[len(videos)-1]
+		next = &cursor.Cursor{CreatedAt: last.CreatedAt.UnixMilli(), ID: int64(last.ID)}
+	}
+	return res, next, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+)
+
+// maxShareChannelLength 分享渠道名的长度上限
+const maxShareChannelLength = 16
+
+// shareSecret 分享码签名密钥，视频服务启动时读取
+var shareSecret []byte
+
+// loadShareSecret 读取分享码签名密钥，相对路径以配置文件所在目录为准
+func loadShareSecret(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.New("share secret file is not configured")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(config.Viper.ConfigFileUsed()), path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	secret := bytes.TrimSpace(data)
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("share secret file %s is empty", path)
+	}
+	return secret, nil
+}
+
+// shareCode 生成分享码
+func shareCode(videoID int64, sharerID int64) string {
+	return tool.EncodeShareCode(shareSecret, videoID, sharerID)
+}
+
+// parseShareCode 校验并解析分享码
+func parseShareCode(code string) (videoID int64, sharerID int64, err error) {
+	return tool.DecodeShareCode(shareSecret, code)
+}
+
+// shareURL 根据分享码生成分享链接
+func shareURL(code string) string {
+	return strings.TrimSuffix(config.Viper.GetString("video.share.baseUrl"), "/") + "/" + code
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	video "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/video"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// trendingTags 热门话题缓存，由定时任务刷新
+var trendingTags struct {
+	mu   sync.RWMutex
+	tags []*db.TagTrend
+}
+
+// refreshTrendingTags 重新计算热门话题
+func refreshTrendingTags() ([]*db.TagTrend, error) {
+	since := time.Now().Add(-config.Viper.GetDuration("video.topic.trendingWindow"))
+	tags, err := db.GetTrendingTags(context.Background(), since,
+		config.Viper.GetFloat64("video.topic.weights.publish"),
+		config.Viper.GetFloat64("video.topic.weights.favorite"),
+		config.Viper.GetInt("video.topic.trendingLimit"))
+	if err != nil {
+		return nil, err
+	}
+	trendingTags.mu.Lock()
+	trendingTags.tags = tags
+	trendingTags.mu.Unlock()
+	return tags, nil
+}
+
+// refreshTrendingTagsJob 定时刷新热门话题
+func refreshTrendingTagsJob() {
+	if _, err := refreshTrendingTags(); err != nil {
+		zap.InitLogger().Errorf("热门话题计算失败：%v", err.Error())
+	}
+}
+
+// getTrendingTags 获取热门话题，缓存尚未生成时同步计算
+func getTrendingTags() ([]*db.TagTrend, error) {
+	trendingTags.mu.RLock()
+	tags := trendingTags.tags
+	trendingTags.mu.RUnlock()
+	if tags != nil {
+		return tags, nil
+	}
+	return refreshTrendingTags()
+}
+
+// normalizeTag 规范化请求中的话题名，与提取话题时保持一致
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// packTag 组装话题信息
+func packTag(t *db.Tag, score float64) *video.Tag {
+	return &video.Tag{
+		Id:         int64(t.ID),
+		Name:       t.Name,
+		VideoCount: int64(t.VideoCount),
+		Score:      score,
+	}
+}
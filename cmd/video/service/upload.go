@@ -3,18 +3,26 @@ package service
 import (
 	"bytes"
 	"fmt"
+	"image"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
 )
 
 // uploadVideo 上传视频至 Minio
-func uploadVideo(data []byte, videoTitle string) (string, error) {
+func uploadVideo(data []byte, videoTitle string, contentType string) (string, error) {
 	logger := zap.InitLogger()
 
 	// 将视频数据上传至minio
 	reader := bytes.NewReader(data)
-	contentType := "application/mp4"
 
 	uploadSize, err := minio.UploadFileByIO(minio.VideoBucketName, videoTitle, reader, int64(len(data)), contentType)
 	if err != nil {
@@ -34,48 +42,146 @@ func uploadVideo(data []byte, videoTitle string) (string, error) {
 	return playUrl, nil
 }
 
-// uploadCover 截取并上传封面至 Minio
-func uploadCover(playUrl string, coverTitle string) error {
+// uploadCoverImage 将封面编码为 JPEG 上传至 Minio，同时生成多个尺寸的缩略图，返回以逗号分隔的缩略图宽度
+func uploadCoverImage(img image.Image, coverTitle string) (string, error) {
 	logger := zap.InitLogger()
+	contentType := "image/jpeg"
 
-	// 截取第一帧并将图像上传至minio
-	imgBuffer, err := tool.GetSnapshotImageBuffer(playUrl, 1)
+	buf, err := tool.EncodeJPEG(img, 0)
 	if err != nil {
-		logger.Errorf("服务器内部错误，封面获取失败：%s", err.Error())
-		return err
+		logger.Errorf("封面编码失败：%v", err.Error())
+		return "", err
 	}
-	var imgByte []byte
-	imgBuffer.Write(imgByte)
-	contentType := "image/png"
-
-	uploadSize, err := minio.UploadFileByIO(minio.CoverBucketName, coverTitle, imgBuffer, int64(imgBuffer.Len()), contentType)
+	uploadSize, err := minio.UploadFileByIO(minio.CoverBucketName, coverTitle, buf, int64(buf.Len()), contentType)
 	if err != nil {
 		logger.Errorf("封面上传至minio失败：%v", err.Error())
-		return err
+		return "", err
 	}
+	logger.Infof("封面文件大小为：%v", uploadSize)
 
-	// 获取上传文件的路径
-	coverUrl, err := minio.GetFileTemporaryURL(minio.CoverBucketName, coverTitle)
+	widths := make([]string, 0)
+	for _, width := range config.Viper.GetIntSlice("video.cover.thumbnailWidths") {
+		buf, err := tool.EncodeJPEG(img, width)
+		if err != nil {
+			logger.Errorf("缩略图编码失败：%v", err.Error())
+			return "", err
+		}
+		_, err = minio.UploadFileByIO(minio.CoverBucketName, tool.ThumbnailName(coverTitle, width), buf, int64(buf.Len()), contentType)
+		if err != nil {
+			logger.Errorf("缩略图上传至minio失败：%v", err.Error())
+			return "", err
+		}
+		widths = append(widths, strconv.Itoa(width))
+	}
+	return strings.Join(widths, ","), nil
+}
+
+// CoverPublish 为已上传至 Minio 的视频选取封面并上传，返回以逗号分隔的缩略图宽度
+func CoverPublish(videoTitle string, coverTitle string, duration float64) (string, error) {
+	logger := zap.InitLogger()
+
+	playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, videoTitle)
 	if err != nil {
-		logger.Errorf("封面获取链接失败：%v", err.Error())
-		return err
+		logger.Errorf("服务器内部错误：视频获取失败：%s", err.Error())
+		return "", err
 	}
-	logger.Infof("上传封面路径：%v", coverUrl)
-	logger.Infof("封面文件大小为：%v", uploadSize)
-	fmt.Println("封面文件大小为：", uploadSize)
+	img, err := tool.SelectCoverFrame(playUrl, duration, config.Viper.GetInt("video.cover.samples"))
+	if err != nil {
+		logger.Errorf("服务器内部错误，封面获取失败：%s", err.Error())
+		return "", err
+	}
+	return uploadCoverImage(img, coverTitle)
+}
+
+// hlsContentType 根据文件后缀获取 HLS 文件的 Content-Type
+func hlsContentType(name string) string {
+	switch filepath.Ext(name) {
+	case ".m3u8":
+		return "application/vnd.apple.mpegurl"
+	case ".ts":
+		return "video/mp2t"
+	default:
+		return "application/octet-stream"
+	}
+}
 
-	return nil
+// hlsPrefix 获取视频 HLS 文件在存储桶中的目录
+func hlsPrefix(videoTitle string) string {
+	return config.Viper.GetString("video.hls.prefix") + strings.TrimSuffix(videoTitle, path.Ext(videoTitle)) + "/"
 }
 
-// VideoPublish 上传视频并获取封面
-func VideoPublish(data []byte, videoTitle string, coverTitle string) error {
-	playUrl, err := uploadVideo(data, videoTitle)
+// HLSPublish 将已上传至 Minio 的视频转码为多码率 HLS 并上传，返回主播放列表的对象名
+func HLSPublish(videoTitle string) (string, error) {
+	logger := zap.InitLogger()
+
+	playUrl, err := minio.GetFileTemporaryURL(minio.VideoBucketName, videoTitle)
 	if err != nil {
-		return err
+		logger.Errorf("服务器内部错误：视频获取失败：%s", err.Error())
+		return "", err
 	}
-	err = uploadCover(playUrl, coverTitle)
+
+	var renditions []tool.HLSRendition
+	if err = config.Viper.UnmarshalKey("video.hls.renditions", &renditions); err != nil {
+		logger.Errorf("HLS 档位配置解析失败：%s", err.Error())
+		return "", err
+	}
+	outputDir, err := os.MkdirTemp("", "hls-*")
 	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(outputDir)
+
+	if err = tool.TranscodeHLS(playUrl, outputDir, renditions, config.Viper.GetInt("video.hls.segmentDuration")); err != nil {
+		logger.Errorf("视频转码失败：%s", err.Error())
+		return "", err
+	}
+
+	// 按原视频对象名划分目录，播放列表中的分片均为相对路径
+	prefix := hlsPrefix(videoTitle)
+	err = filepath.WalkDir(outputDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(outputDir, p)
+		if err != nil {
+			return err
+		}
+		_, err = minio.UploadFileByPath(minio.VideoBucketName, prefix+filepath.ToSlash(rel), p, hlsContentType(p))
 		return err
+	})
+	if err != nil {
+		logger.Errorf("HLS 文件上传至minio失败：%s", err.Error())
+		return "", err
+	}
+	return prefix + tool.HLSMasterPlaylist, nil
+}
+
+// removeVideoObjects 删除视频在 Minio 中的原视频、HLS 文件、封面及缩略图
+func removeVideoObjects(v *db.Video) error {
+	logger := zap.InitLogger()
+	var lastErr error
+
+	if err := minio.RemoveFile(minio.VideoBucketName, v.PlayUrl); err != nil {
+		logger.Errorf("Minio删除视频失败：%v", err.Error())
+		lastErr = err
+	}
+	if err := minio.RemoveFilesByPrefix(minio.VideoBucketName, hlsPrefix(v.PlayUrl)); err != nil {
+		logger.Errorf("Minio删除HLS文件失败：%v", err.Error())
+		lastErr = err
+	}
+	if err := minio.RemoveFile(minio.CoverBucketName, v.CoverUrl); err != nil {
+		logger.Errorf("Minio删除封面失败：%v", err.Error())
+		lastErr = err
+	}
+	for _, w := range strings.Split(v.CoverThumbs, ",") {
+		width, err := strconv.Atoi(w)
+		if err != nil {
+			continue
+		}
+		if err = minio.RemoveFile(minio.CoverBucketName, tool.ThumbnailName(v.CoverUrl, width)); err != nil {
+			logger.Errorf("Minio删除缩略图失败：%v", err.Error())
+			lastErr = err
+		}
 	}
-	return nil
+	return lastErr
 }
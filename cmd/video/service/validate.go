@@ -0,0 +1,132 @@
+package service
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
+)
+
+// videoFormat 支持的容器格式，按优先级排列
+type videoFormat struct {
+	name        string // ffprobe 返回的格式名
+	ext         string // 对象扩展名
+	contentType string
+}
+
+var videoFormats = []videoFormat{
+	{"mp4", ".mp4", "video/mp4"},
+	{"mov", ".mov", "video/quicktime"},
+	{"matroska", ".mkv", "video/x-matroska"},
+	{"webm", ".webm", "video/webm"},
+}
+
+// matchVideoFormat 根据探测到的容器格式获取对应的扩展名与 Content-Type
+func matchVideoFormat(meta *tool.VideoMeta) (videoFormat, bool) {
+	for _, f := range videoFormats {
+		for _, name := range meta.Formats {
+			if f.name == name {
+				return f, true
+			}
+		}
+	}
+	return videoFormat{}, false
+}
+
+// videoFormatByName 根据文件扩展名获取声明的视频格式，未提供文件名时按 mp4 处理
+func videoFormatByName(fileName string) (videoFormat, bool) {
+	if fileName == "" {
+		return videoFormats[0], true
+	}
+	ext := strings.ToLower(path.Ext(fileName))
+	for _, f := range videoFormats {
+		if f.ext == ext {
+			return f, true
+		}
+	}
+	return videoFormat{}, false
+}
+
+// probedAs 探测到的容器格式是否包含该格式，ffprobe 对同一类容器返回多个格式名，如 mov,mp4,m4a
+func (f videoFormat) probedAs(meta *tool.VideoMeta) bool {
+	for _, name := range meta.Formats {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateVideo 校验视频元数据，不符合要求时返回带有对应错误码的 errno.ErrNo
+func validateVideo(meta *tool.VideoMeta) error {
+	if _, ok := matchVideoFormat(meta); !ok {
+		return errno.ErrVideoInvalidFormat.WithMessage("不支持的视频格式")
+	}
+	if meta.Duration <= 0 {
+		return errno.ErrVideoInvalidFormat.WithMessage("无法识别视频时长")
+	}
+	if maxDuration := config.Viper.GetFloat64("video.validation.maxDuration"); meta.Duration > maxDuration {
+		return errno.ErrVideoDurationExceeded.WithMessage(fmt.Sprintf("视频时长不能超过%.0f秒", maxDuration))
+	}
+	maxResolution := config.Viper.GetInt("video.validation.maxResolution")
+	if meta.Width > maxResolution || meta.Height > maxResolution {
+		return errno.ErrVideoResolutionExceeded.WithMessage(fmt.Sprintf("视频分辨率不能超过%d像素", maxResolution))
+	}
+	return nil
+}
+
+// probeVideo 探测并校验视频，videoPath 可以是本地路径或视频链接
+func probeVideo(videoPath string) (*tool.VideoMeta, error) {
+	meta, err := tool.ProbeVideo(videoPath)
+	if err != nil {
+		return nil, errno.ErrVideoInvalidFormat.WithMessage("上传的文件不是有效的视频")
+	}
+	if err = validateVideo(meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// decodeCover 解码并校验自定义封面
+func decodeCover(data []byte) (image.Image, error) {
+	if maxSize := config.Viper.GetInt("video.cover.maxSizeLimit"); len(data) > maxSize*1000*1000 {
+		return nil, errno.ErrCoverSizeExceeded.WithMessage(fmt.Sprintf("封面图片不能大于%dMB", maxSize))
+	}
+	img, err := tool.DecodeImage(data, config.Viper.GetInt("video.cover.maxPixels"))
+	if err == tool.ErrImageTooLarge {
+		return nil, errno.ErrCoverResolutionExceeded.WithMessage("封面图片分辨率过大")
+	} else if err != nil {
+		return nil, errno.ErrCoverInvalidFormat.WithMessage("不支持的封面图片格式")
+	}
+	return img, nil
+}
+
+// probeVideoData 将视频数据写入临时文件后探测并校验
+func probeVideoData(data []byte) (*tool.VideoMeta, error) {
+	f, err := os.CreateTemp("", "video-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return nil, err
+	}
+	return probeVideo(f.Name())
+}
+
+// publishVisibility 校验发布时指定的可见范围，未指定时默认公开
+func publishVisibility(visibility int32) (uint, bool) {
+	if visibility == 0 {
+		return db.VisibilityPublic, true
+	}
+	return uint(visibility), visibility > 0 && db.ValidVisibility(uint(visibility))
+}
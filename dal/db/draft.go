@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+// 视频发布状态，仅已发布的视频出现在各类视频列表中
+const (
+	PublishStatusPublished uint = iota + 1 // 已发布
+	PublishStatusDraft                     // 草稿，仅作者可见
+	PublishStatusScheduled                 // 定时发布，到达发布时间后由定时任务发布
+)
+
+// lockVideo 在事务中以加锁读的方式获取视频，避免发布与处理任务同时修改作品数量。视频不存在时返回 nil
+func lockVideo(tx *gorm.DB, videoID int64) (*Video, error) {
+	video := new(Video)
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", videoID).First(video).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+// publishVideo 在事务中发布已加锁的草稿或定时发布视频，发布时间作为视频的投稿时间，视频已处理完成时同步作者的作品数量
+func publishVideo(tx *gorm.DB, video *Video, at time.Time) error {
+	err := tx.Model(video).Updates(map[string]interface{}{
+		"publish_status": PublishStatusPublished,
+		"publish_at":     at,
+		"created_at":     at,
+	}).Error
+	if err != nil {
+		return err
+	}
+	if video.ProcessStatus != VideoStatusReady {
+		return nil
+	}
+	res := tx.Model(&User{}).Where("id = ?", video.AuthorID).Update("work_count", gorm.Expr("work_count + ?", 1))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected != 1 {
+		return errno.ErrDatabase
+	}
+	return nil
+}
+
+// GetDraftVideosByUserID
+//
+//	@Description: 按创建时间倒序获取用户尚未发布的草稿及定时发布视频
+//	@Date 2026-10-18 19:42:05
+//	@param ctx 数据库操作上下文
+//	@param authorID 作者的用户id
+//	@param limit 获取的视频条数
+//	@param c 分页游标，为 nil 时从最近创建的视频开始
+//	@return []*Video 视频列表
+//	@return error
+func GetDraftVideosByUserID(ctx context.Context, authorID int64, limit int, c *cursor.Cursor) ([]*Video, error) {
+	videos := make([]*Video, 0)
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Scopes(beforeCursor(c, "created_at", "id")).
+		Where("author_id = ? AND publish_status IN ?", authorID, []uint{PublishStatusDraft, PublishStatusScheduled}).
+		Limit(limit).Order("created_at desc, id desc").Find(&videos).Error
+	if err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// ScheduleVideoPublish
+//
+//	@Description: 根据视频id和作者id发布草稿或定时发布的视频，发布时间晚于当前时间时改为定时发布
+//	@Date 2026-10-18 19:45:31
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param authorID 作者id
+//	@param publishAt 发布时间，为 nil 表示立即发布
+//	@return bool 是否找到对应的未发布视频
+//	@return error
+func ScheduleVideoPublish(ctx context.Context, videoID int64, authorID int64, publishAt *time.Time) (bool, error) {
+	found := false
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		video, err := lockVideo(tx, videoID)
+		if err != nil {
+			return err
+		}
+		if video == nil || int64(video.AuthorID) != authorID || video.PublishStatus == PublishStatusPublished {
+			return nil
+		}
+		found = true
+		if publishAt == nil || !publishAt.After(time.Now()) {
+			return publishVideo(tx, video, time.Now())
+		}
+		return tx.Model(video).Updates(map[string]interface{}{
+			"publish_status": PublishStatusScheduled,
+			"publish_at":     *publishAt,
+		}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// PublishDueVideos
+//
+//	@Description: 发布已到达发布时间的定时发布视频，以计划的发布时间作为投稿时间
+//	@Date 2026-10-18 19:48:12
+//	@param ctx 数据库操作上下文
+//	@param now 当前时间
+//	@param limit 单次最多发布的视频数
+//	@return int 本次发布的视频数
+//	@return error
+func PublishDueVideos(ctx context.Context, now time.Time, limit int) (int, error) {
+	var videos []*Video
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Select("id", "publish_at").
+		Where("publish_status = ? AND publish_at <= ?", PublishStatusScheduled, now).
+		Order("publish_at").Limit(limit).Find(&videos).Error
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, v := range videos {
+		// 每个视频单独提交，加锁后重新校验，跳过已被其他实例发布或被作者修改了发布时间的视频
+		err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			video, err := lockVideo(tx, int64(v.ID))
+			if err != nil {
+				return err
+			}
+			if video == nil || video.PublishStatus != PublishStatusScheduled || video.PublishAt == nil || video.PublishAt.After(now) {
+				return nil
+			}
+			if err = publishVideo(tx, video, *video.PublishAt); err != nil {
+				return err
+			}
+			published++
+			return nil
+		})
+		if err != nil {
+			return published, err
+		}
+	}
+	return published, nil
+}
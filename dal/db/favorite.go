@@ -10,7 +10,9 @@ package db
 
 import (
 	"context"
+	"time"
 
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
 	"gorm.io/gorm"
 	"gorm.io/plugin/dbresolver"
@@ -20,10 +22,11 @@ import (
 //
 //	@Description: 用户与视频的点赞关系数据模型
 type FavoriteVideoRelation struct {
-	Video   Video `gorm:"foreignkey:VideoID;" json:"video,omitempty"`
-	VideoID uint  `gorm:"index:idx_videoid;not null" json:"video_id"`
-	User    User  `gorm:"foreignkey:UserID;" json:"user,omitempty"`
-	UserID  uint  `gorm:"index:idx_userid;not null" json:"user_id"`
+	Video     Video     `gorm:"foreignkey:VideoID;" json:"video,omitempty"`
+	VideoID   uint      `gorm:"index:idx_videoid;not null" json:"video_id"`
+	User      User      `gorm:"foreignkey:UserID;" json:"user,omitempty"`
+	UserID    uint      `gorm:"index:idx_userid;index:idx_userid_created,priority:1;not null" json:"user_id"`
+	CreatedAt time.Time `gorm:"index:idx_userid_created,priority:2;default:CURRENT_TIMESTAMP(3);not null" json:"created_at"` // 点赞时间，用于喜欢列表分页
 }
 
 // FavoriteCommentRelation
@@ -177,17 +180,74 @@ func DelFavoriteByUserVideoID(ctx context.Context, userID int64, videoID int64,
 	return err
 }
 
+// GetFavoriteVideoRelationsByUserVideoIDs
+//
+//	@Description: 批量获取用户对多个视频的点赞关系
+//	@Date 2026-10-18 15:50:13
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param videoIDs 视频id列表
+//	@return []*FavoriteVideoRelation 已存在的点赞关系列表
+//	@return error
+func GetFavoriteVideoRelationsByUserVideoIDs(ctx context.Context, userID int64, videoIDs []int64) ([]*FavoriteVideoRelation, error) {
+	res := make([]*FavoriteVideoRelation, 0)
+	if len(videoIDs) == 0 {
+		return res, nil
+	}
+
+	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("user_id = ? AND video_id IN ?", userID, videoIDs).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountFavoritesByUserIDsVideoIDs
+//
+//	@Description: 统计一组用户对多个视频的点赞人数
+//	@Date 2026-10-18 16:20:41
+//	@param ctx 数据库操作上下文
+//	@param userIDs 用户id列表
+//	@param videoIDs 视频id列表
+//	@return map[uint]int64 视频id到点赞人数的映射，无人点赞的视频不包含在内
+//	@return error
+func CountFavoritesByUserIDsVideoIDs(ctx context.Context, userIDs []int64, videoIDs []int64) (map[uint]int64, error) {
+	res := make(map[uint]int64)
+	if len(userIDs) == 0 || len(videoIDs) == 0 {
+		return res, nil
+	}
+
+	var counts []struct {
+		VideoID uint
+		Count   int64
+	}
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Model(&FavoriteVideoRelation{}).
+		Select("video_id, COUNT(*) AS count").
+		Where("user_id IN ? AND video_id IN ?", userIDs, videoIDs).
+		Group("video_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		res[c.VideoID] = c.Count
+	}
+	return res, nil
+}
+
 // GetFavoriteListByUserID
 //
-//	@Description: 根据用户id获取用户的点赞关系列表
+//	@Description: 按点赞时间倒序获取用户的点赞关系列表
 //	@Date 2023-01-21 17:08:52
 //	@param ctx 数据库操作上下文
 //	@param userID 用户id
+//	@param limit 获取的条数
+//	@param c 分页游标，游标id为视频id，为 nil 时从最近的点赞开始
 //	@return []*FavoriteVideoRelation 点赞关系列表
 //	@return error
-func GetFavoriteListByUserID(ctx context.Context, userID int64) ([]*FavoriteVideoRelation, error) {
+func GetFavoriteListByUserID(ctx context.Context, userID int64, limit int, c *cursor.Cursor) ([]*FavoriteVideoRelation, error) {
 	var FavoriteVideoRelationList []*FavoriteVideoRelation
-	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("user_id = ?", userID).Find(&FavoriteVideoRelationList).Error
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Scopes(beforeCursor(c, "created_at", "video_id")).
+		Where("user_id = ?", userID).Limit(limit).Order("created_at desc, video_id desc").Find(&FavoriteVideoRelationList).Error
 	if err != nil {
 		return nil, err
 	}
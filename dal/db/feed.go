@@ -10,8 +10,11 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+
 	"gorm.io/gorm"
 	"gorm.io/plugin/dbresolver"
 )
@@ -21,40 +24,75 @@ import (
 //	@Description: 视频数据模型
 type Video struct {
 	ID            uint      `gorm:"primarykey"`
-	CreatedAt     time.Time `gorm:"not null;index:idx_create" json:"created_at,omitempty"`
+	CreatedAt     time.Time `gorm:"not null;index:idx_create" json:"created_at,omitempty"` // 投稿时间，草稿及定时发布的视频在发布时更新为发布时间
 	UpdatedAt     time.Time
 	DeletedAt     gorm.DeletedAt `gorm:"index"`
 	Author        User           `gorm:"foreignkey:AuthorID" json:"author,omitempty"`
 	AuthorID      uint           `gorm:"index:idx_authorid;not null" json:"author_id,omitempty"`
 	PlayUrl       string         `gorm:"type:varchar(255);not null" json:"play_url,omitempty"`
+	HlsUrl        string         `gorm:"type:varchar(255)" json:"hls_url,omitempty"` // HLS 主播放列表，转码完成前为空
 	CoverUrl      string         `gorm:"type:varchar(255)" json:"cover_url,omitempty"`
 	FavoriteCount uint           `gorm:"default:0;not null" json:"favorite_count,omitempty"`
 	CommentCount  uint           `gorm:"default:0;not null" json:"comment_count,omitempty"`
-	Title         string         `gorm:"type:varchar(50);not null" json:"title,omitempty"`
+	PlayCount     uint           `gorm:"default:0;not null" json:"play_count,omitempty"`
+	ShareCount    uint           `gorm:"default:0;not null" json:"share_count,omitempty"`
+	WatchDuration float64        `gorm:"default:0;not null" json:"watch_duration,omitempty"` // 累计观看时长，单位秒
+	Title         string         `gorm:"index:idx_title_fulltext,class:FULLTEXT,option:WITH PARSER ngram;type:varchar(50);not null" json:"title,omitempty"`
+	ProcessStatus uint           `gorm:"index:idx_process_status;default:4;not null" json:"process_status,omitempty"` // 处理状态，仅处理完成的视频对外可见
+	Duration      float64        `gorm:"default:0;not null" json:"duration,omitempty"`                                // 时长，单位秒
+	Width         int            `gorm:"default:0;not null" json:"width,omitempty"`
+	Height        int            `gorm:"default:0;not null" json:"height,omitempty"`
+	Codec         string         `gorm:"type:varchar(32)" json:"codec,omitempty"`                                     // 视频编码
+	CoverThumbs   string         `gorm:"type:varchar(64)" json:"cover_thumbs,omitempty"`                              // 已生成的封面缩略图宽度，以逗号分隔，为空表示封面尚未生成
+	Visibility    uint           `gorm:"default:1;not null" json:"visibility,omitempty"`                              // 可见范围，1-公开，2-粉丝可见，3-好友可见，4-仅自己可见
+	PublishStatus uint           `gorm:"index:idx_publish_status;default:1;not null" json:"publish_status,omitempty"` // 发布状态，1-已发布，2-草稿，3-定时发布
+	PublishAt     *time.Time     `gorm:"index:idx_publish_at" json:"publish_at,omitempty"`                            // 发布时间，定时发布的视频为计划的发布时间
 }
 
 func (Video) TableName() string {
 	return "videos"
 }
 
+// beforeCursor
+//
+//	@Description: 按 (created_at, id) 倒序键集分页的查询条件，仅保留位于游标之后（更早）的记录
+//	@Date 2026-10-18 16:42:10
+//	@param c 分页游标，为 nil 时不限制
+//	@param createdAtColumn 创建时间列名
+//	@param idColumn id列名
+//	@return func(*gorm.DB) *gorm.DB 查询条件
+func beforeCursor(c *cursor.Cursor, createdAtColumn, idColumn string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if c == nil {
+			return db
+		}
+		t := time.UnixMilli(c.CreatedAt)
+		return db.Where(fmt.Sprintf("(%s < ? OR (%s = ? AND %s < ?))", createdAtColumn, createdAtColumn, idColumn), t, t, c.ID)
+	}
+}
+
 // MGetVideos
 //
-//	@Description: 获取最近发布的视频
+//	@Description: 按发布时间倒序获取游标之后对当前用户可见的视频
 //	@Date 2023-01-21 16:39:00
 //	@param ctx
 //	@param limit 获取的视频条数
-//	@param latestTime 最早的时间限制
+//	@param c 分页游标，为 nil 时从最新发布的视频开始
+//	@param authorIDs 作者id列表，为 nil 时不限制作者
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
 //	@return []*Video 视频列表
 //	@return error
-func MGetVideos(ctx context.Context, limit int, latestTime *int64) ([]*Video, error) {
+func MGetVideos(ctx context.Context, limit int, c *cursor.Cursor, authorIDs []int64, viewerID int64) ([]*Video, error) {
 	videos := make([]*Video, 0)
+	if authorIDs != nil && len(authorIDs) == 0 {
+		return videos, nil
+	}
 
-	if latestTime == nil || *latestTime == 0 {
-		curTime := time.Now().UnixMilli()
-		latestTime = &curTime
+	conn := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Scopes(beforeCursor(c, "created_at", "id"), visibleTo(viewerID)).Where("process_status = ?", VideoStatusReady)
+	if authorIDs != nil {
+		conn = conn.Where("author_id IN ?", authorIDs)
 	}
-	conn := GetDB().Clauses(dbresolver.Read).WithContext(ctx)
-	if err := conn.Limit(limit).Order("created_at desc").Find(&videos, "created_at < ?", time.UnixMilli(*latestTime)).Error; err != nil {
+	if err := conn.Limit(limit).Order("created_at desc, id desc").Find(&videos).Error; err != nil {
 		return nil, err
 	}
 	return videos, nil
@@ -93,7 +131,7 @@ func GetVideoListByIDs(ctx context.Context, videoIDs []int64) ([]*Video, error)
 		return res, nil
 	}
 
-	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("video_id in ?", videoIDs).Find(&res).Error; err != nil {
+	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("id in ?", videoIDs).Find(&res).Error; err != nil {
 		return nil, err
 	}
 	return res, nil
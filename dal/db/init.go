@@ -61,7 +61,7 @@ func init() {
 	}))
 	// AutoMigrate会创建表，缺失的外键，约束，列和索引。如果大小，精度，是否为空，可以更改，则AutoMigrate会改变列的类型。出于保护您数据的目的，它不会删除未使用的列
 	// 刷新数据库的表格，使其保持最新。即如果我在旧表的基础上增加一个字段age，那么调用autoMigrate后，旧表会自动多出一列age，值为空
-	if err := _db.AutoMigrate(&User{}, &Video{}, &Comment{}, &FavoriteVideoRelation{}, &FollowRelation{}, &Message{}, &FavoriteCommentRelation{}); err != nil {
+	if err := _db.AutoMigrate(&User{}, &Video{}, &Comment{}, &FavoriteVideoRelation{}, &FollowRelation{}, &Message{}, &FavoriteCommentRelation{}, &UploadSession{}, &PublishJob{}, &Tag{}, &VideoTag{}, &VideoShare{}, &UserNameHistory{}, &UserSession{}, &UserTOTP{}, &UserRecoveryCode{}); err != nil {
 		zapLogger.Fatalln(err.Error())
 	}
 
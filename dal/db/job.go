@@ -0,0 +1,214 @@
+//
+// Package db
+// @Description: 数据库数据库操作业务逻辑
+// @Author hehehhh
+// @Date 2023-01-21 14:33:47
+// @Update
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// 视频处理状态，同时用于 Video.ProcessStatus 与 PublishJob.Status
+const (
+	VideoStatusPending     uint = iota + 1 // 排队等待处理
+	VideoStatusUploading                   // 正在上传至对象存储
+	VideoStatusTranscoding                 // 正在处理（截取封面等）
+	VideoStatusReady                       // 处理完成，对外可见
+	VideoStatusFailed                      // 处理失败
+)
+
+// PublishJob
+//
+//	@Description: 视频发布处理任务数据模型
+type PublishJob struct {
+	gorm.Model
+	Video   Video  `gorm:"foreignkey:VideoID" json:"video,omitempty"`
+	VideoID uint   `gorm:"index:idx_videoid,unique;not null" json:"video_id"`
+	Status  uint   `gorm:"index:idx_status;not null" json:"status"`
+	Retries uint   `gorm:"default:0;not null" json:"retries"`
+	ErrMsg  string `gorm:"type:varchar(255)" json:"err_msg,omitempty"`
+}
+
+func (PublishJob) TableName() string {
+	return "publish_jobs"
+}
+
+// truncateErrMsg 截断过长的失败原因，避免超出字段长度
+func truncateErrMsg(errMsg string) string {
+	if r := []rune(errMsg); len(r) > 255 {
+		return string(r[:255])
+	}
+	return errMsg
+}
+
+// CreatePublishJob
+//
+//	@Description: 新增一条视频记录及其处理任务，视频在处理完成前对外不可见，也不计入作品数
+//	@Date 2026-10-18 11:02:16
+//	@param ctx 数据库操作上下文
+//	@param video 视频数据
+//	@param status 初始处理状态
+//	@param tags 标题中的话题名列表
+//	@return *PublishJob 处理任务数据
+//	@return error
+func CreatePublishJob(ctx context.Context, video *Video, status uint, tags []string) (*PublishJob, error) {
+	var job *PublishJob
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		job, err = createPublishJob(tx, video, status, tags)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// createPublishJob 在事务中新增视频记录、话题关联及处理任务
+func createPublishJob(tx *gorm.DB, video *Video, status uint, tags []string) (*PublishJob, error) {
+	// 1. 在 video 表中创建视频记录
+	video.ProcessStatus = status
+	if err := tx.Create(video).Error; err != nil {
+		return nil, err
+	}
+	// 2. 关联标题中的话题
+	if err := setVideoTags(tx, int64(video.ID), tags); err != nil {
+		return nil, err
+	}
+	// 3. 在 publish_jobs 表中创建处理任务
+	job := &PublishJob{Status: status, VideoID: video.ID}
+	if err := tx.Create(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetPublishJobByVideoID
+//
+//	@Description: 根据视频id获取处理任务，同时加载对应的视频数据
+//	@Date 2026-10-18 11:04:40
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@return *PublishJob 处理任务数据
+//	@return error
+func GetPublishJobByVideoID(ctx context.Context, videoID int64) (*PublishJob, error) {
+	res := new(PublishJob)
+	if err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Preload("Video").Where("video_id = ?", videoID).First(&res).Error; err == nil {
+		return res, nil
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
+
+// UpdatePublishJobStatus
+//
+//	@Description: 更新处理任务及对应视频的处理状态，已发布的视频首次处理完成时同步作者的作品数量
+//	@Date 2026-10-18 11:06:12
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param status 新的处理状态
+//	@param errMsg 失败原因，非失败状态时传空串
+//	@return error
+func UpdatePublishJobStatus(ctx context.Context, videoID int64, status uint, errMsg string) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		job := new(PublishJob)
+		if err := tx.Where("video_id = ?", videoID).First(job).Error; err != nil {
+			return err
+		}
+		// 已完成的任务不再变更状态，保证作品数只被累加一次
+		if job.Status == VideoStatusReady {
+			return nil
+		}
+		// 1. 更新处理任务状态
+		err := tx.Model(job).Updates(map[string]interface{}{
+			"status":  status,
+			"err_msg": truncateErrMsg(errMsg),
+		}).Error
+		if err != nil {
+			return err
+		}
+		// 2. 同步 video 表中的处理状态，加锁读取以获取最新的发布状态
+		video, err := lockVideo(tx, videoID)
+		if err != nil {
+			return err
+		}
+		if video == nil {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Model(video).Update("process_status", status).Error; err != nil {
+			return err
+		}
+		// 草稿及定时发布的视频在发布时计入作品数
+		if status != VideoStatusReady || video.PublishStatus != PublishStatusPublished {
+			return nil
+		}
+		// 3. 处理完成后同步 user 表中的作品数量
+		res := tx.Model(&User{}).Where("id = ?", video.AuthorID).Update("work_count", gorm.Expr("work_count + ?", 1))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != 1 {
+			return errno.ErrDatabase
+		}
+		return nil
+	})
+}
+
+// IncrPublishJobRetries
+//
+//	@Description: 处理任务的重试次数加一，并记录本次失败原因
+//	@Date 2026-10-18 11:09:55
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param errMsg 本次失败原因
+//	@return uint 累加后的重试次数
+//	@return error
+func IncrPublishJobRetries(ctx context.Context, videoID int64, errMsg string) (uint, error) {
+	job := new(PublishJob)
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&PublishJob{}).Where("video_id = ?", videoID).Updates(map[string]interface{}{
+			"retries": gorm.Expr("retries + ?", 1),
+			"err_msg": truncateErrMsg(errMsg),
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("video_id = ?", videoID).First(job).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return job.Retries, nil
+}
+
+// GetStalePublishJobs
+//
+//	@Description: 获取在指定时间之前便停留在某一状态的处理任务
+//	@Date 2026-10-18 11:12:21
+//	@param ctx 数据库操作上下文
+//	@param status 处理状态
+//	@param before 截止时间
+//	@param limit 最大返回数量
+//	@return []*PublishJob 处理任务列表
+//	@return error
+func GetStalePublishJobs(ctx context.Context, status uint, before time.Time, limit int) ([]*PublishJob, error) {
+	jobs := make([]*PublishJob, 0)
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).
+		Where("status = ? AND updated_at < ?", status, before).
+		Order("updated_at").Limit(limit).Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+var (
+	ErrUserNameTaken  = errors.New("user name already taken")
+	ErrRenameTooOften = errors.New("user name changed too often")
+)
+
+// UserNameHistory
+//
+//	@Description: 用户名修改记录数据模型，用于限制修改频率
+type UserNameHistory struct {
+	ID        uint      `gorm:"primarykey"`
+	UserID    uint      `gorm:"index:idx_userid_created;not null" json:"user_id"`
+	OldName   string    `gorm:"type:varchar(40);not null" json:"old_name"`
+	NewName   string    `gorm:"type:varchar(40);not null" json:"new_name"`
+	CreatedAt time.Time `gorm:"index:idx_userid_created" json:"created_at"`
+}
+
+func (UserNameHistory) TableName() string {
+	return "user_name_histories"
+}
+
+// isDuplicateKey 判断是否违反唯一索引
+func isDuplicateKey(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == 1062
+}
+
+// UpdateUserProfile
+//
+//	@Description: 修改用户名及个人简介，修改用户名时记录修改历史，窗口期内修改次数达到上限时返回 ErrRenameTooOften，用户名已被占用时返回 ErrUserNameTaken
+//	@Date 2026-10-18 11:02:37
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param name 新的用户名，为 nil 时不修改
+//	@param signature 新的个人简介，为 nil 时不修改
+//	@param renameLimit 窗口期内允许修改用户名的次数
+//	@param renameWindow 修改用户名的限制窗口期
+//	@return *User 修改后的用户数据，用户不存在时返回 nil
+//	@return error
+func UpdateUserProfile(ctx context.Context, userID int64, name, signature *string, renameLimit int, renameWindow time.Duration) (*User, error) {
+	var res *User
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		usr := new(User)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(usr, userID).Error; err == gorm.ErrRecordNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		updates := make(map[string]interface{})
+		if name != nil && *name != usr.UserName {
+			var count int64
+			if err := tx.Model(&UserNameHistory{}).Where("user_id = ? AND created_at > ?", usr.ID, time.Now().Add(-renameWindow)).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count >= int64(renameLimit) {
+				return ErrRenameTooOften
+			}
+			// 唯一索引包含已注销的用户，且按排序规则比较，仅大小写不同的用户名同样视为冲突
+			var taken int64
+			if err := tx.Unscoped().Model(&User{}).Where("user_name = ? AND id <> ?", *name, usr.ID).Count(&taken).Error; err != nil {
+				return err
+			}
+			if taken > 0 {
+				return ErrUserNameTaken
+			}
+			if err := tx.Create(&UserNameHistory{UserID: usr.ID, OldName: usr.UserName, NewName: *name}).Error; err != nil {
+				return err
+			}
+			updates["user_name"] = *name
+			usr.UserName = *name
+		}
+		if signature != nil && *signature != usr.Signature {
+			updates["signature"] = *signature
+			usr.Signature = *signature
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(&User{}).Where("id = ?", usr.ID).Updates(updates).Error; err != nil {
+				if isDuplicateKey(err) {
+					return ErrUserNameTaken
+				}
+				return err
+			}
+		}
+		res = usr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -10,6 +10,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/errno"
 
 	"gorm.io/gorm"
@@ -46,45 +48,182 @@ func CreateVideo(ctx context.Context, video *Video) error {
 
 // GetVideosByUserID
 //
-//	@Description: 获取用户发布的视频列表
+//	@Description: 按发布时间倒序获取用户发布的、对当前用户可见的视频列表
 //	@Date 2023-01-21 16:28:44
 //	@param ctx 数据库操作上下文
 //	@param authorId 作者的用户id
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
+//	@param limit 获取的视频条数
+//	@param c 分页游标，为 nil 时从最新发布的视频开始
 //	@return []*Video 视频列表
 //	@return error
-func GetVideosByUserID(ctx context.Context, authorId int64) ([]*Video, error) {
-	var pubList []*Video
-	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Model(&Video{}).Where(&Video{AuthorID: uint(authorId)}).Find(&pubList).Error
-	if err != nil {
-		return nil, err
-	}
-	return pubList, nil
+func GetVideosByUserID(ctx context.Context, authorId int64, viewerID int64, limit int, c *cursor.Cursor) ([]*Video, error) {
+	return MGetVideos(ctx, limit, c, []int64{authorId}, viewerID)
 }
 
 // DelVideoByID
 //
-//	@Description: 根据视频id和作者id删除视频
+//	@Description: 根据视频id和作者id删除视频，同时清理视频的点赞、评论、话题、分享记录及处理任务，并同步相关用户的计数
 //	@Date 2023-02-22 23:34:45
+//	@Update 2026-10-18 15:12:30
 //	@param ctx 数据库操作上下文
 //	@param videoID 视频id
 //	@param authorID 作者id
+//	@return *Video 被删除的视频数据，用于清理对象存储
 //	@return error
-func DelVideoByID(ctx context.Context, videoID int64, authorID int64) error {
-	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. 根据主键 video_id 删除 video
-		err := tx.Unscoped().Delete(&Video{}, videoID).Error
-		if err != nil {
+func DelVideoByID(ctx context.Context, videoID int64, authorID int64) (*Video, error) {
+	video := new(Video)
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND author_id = ?", videoID, authorID).First(video).Error; err != nil {
 			return err
 		}
-		// 2. 同步 user 表中的作品数量
+
+		// 1. 同步点赞用户的点赞数量及作者的获赞总数
+		var favoriteCount int64
+		if err := tx.Model(&FavoriteVideoRelation{}).Where("video_id = ?", videoID).Count(&favoriteCount).Error; err != nil {
+			return err
+		}
+		if favoriteCount > 0 {
+			likers := tx.Model(&FavoriteVideoRelation{}).Select("user_id").Where("video_id = ?", videoID)
+			err := tx.Model(&User{}).Where("id IN (?)", likers).Update("favorite_count", gorm.Expr("favorite_count - ?", 1)).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Model(&User{}).Where("id = ?", authorID).Update("total_favorited", gorm.Expr("total_favorited - ?", favoriteCount)).Error
+			if err != nil {
+				return err
+			}
+			// 2. 删除点赞数据
+			if err = tx.Unscoped().Where("video_id = ?", videoID).Delete(&FavoriteVideoRelation{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 3. 删除评论的点赞数据及评论，评论沿用软删除
+		comments := tx.Model(&Comment{}).Select("id").Where("video_id = ?", videoID)
+		if err := tx.Unscoped().Where("comment_id IN (?)", comments).Delete(&FavoriteCommentRelation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("video_id = ?", videoID).Delete(&Comment{}).Error; err != nil {
+			return err
+		}
+
+		// 4. 解除话题，删除分享记录、处理任务及视频
+		if err := setVideoTags(tx, videoID, nil); err != nil {
+			return err
+		}
+		if err := tx.Where("video_id = ?", videoID).Delete(&VideoShare{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("video_id = ?", videoID).Delete(&PublishJob{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&Video{}, videoID).Error; err != nil {
+			return err
+		}
+
+		// 5. 处理完成且已发布的视频才计入作品数，同步 user 表中的作品数量
+		if video.ProcessStatus != VideoStatusReady || video.PublishStatus != PublishStatusPublished {
+			return nil
+		}
 		res := tx.Model(&User{}).Where("id = ?", authorID).Update("work_count", gorm.Expr("work_count - ?", 1))
 		if res.Error != nil {
-			return err
+			return res.Error
 		}
 		if res.RowsAffected != 1 {
 			return errno.ErrDatabase
 		}
 		return nil
 	})
-	return err
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+// UpdateVideoInfo
+//
+//	@Description: 根据视频id和作者id修改视频标题及可见范围
+//	@Date 2026-10-18 15:20:04
+//	@Update 2026-10-18 19:14:26
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param authorID 作者id
+//	@param title 新的标题，为空表示不修改
+//	@param tags 新标题中的话题名列表，替换原有话题，标题不修改时忽略
+//	@param visibility 新的可见范围，为 0 表示不修改
+//	@return bool 是否找到对应视频
+//	@return error
+func UpdateVideoInfo(ctx context.Context, videoID int64, authorID int64, title string, tags []string, visibility uint) (bool, error) {
+	found := false
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Video{}).Where("id = ? AND author_id = ?", videoID, authorID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		found = true
+		if visibility != 0 {
+			if err := tx.Model(&Video{}).Where("id = ?", videoID).Update("visibility", visibility).Error; err != nil {
+				return err
+			}
+		}
+		if title == "" {
+			return nil
+		}
+		if err := tx.Model(&Video{}).Where("id = ?", videoID).Update("title", title).Error; err != nil {
+			return err
+		}
+		return setVideoTags(tx, videoID, tags)
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// UpdateVideoHlsUrl
+//
+//	@Description: 更新视频的 HLS 主播放列表
+//	@Date 2026-10-18 13:21:40
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param hlsUrl HLS 主播放列表的对象名
+//	@return error
+func UpdateVideoHlsUrl(ctx context.Context, videoID int64, hlsUrl string) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&Video{}).Where("id = ?", videoID).Update("hls_url", hlsUrl).Error
+}
+
+// UpdateVideoCoverThumbs
+//
+//	@Description: 更新视频已生成的封面缩略图宽度
+//	@Date 2026-10-18 14:37:08
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param coverThumbs 以逗号分隔的缩略图宽度
+//	@return error
+func UpdateVideoCoverThumbs(ctx context.Context, videoID int64, coverThumbs string) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&Video{}).Where("id = ?", videoID).Update("cover_thumbs", coverThumbs).Error
+}
+
+// IncrVideoPlayStats
+//
+//	@Description: 累加视频的播放数及观看时长，视频已删除时不做处理
+//	@Date 2026-10-18 17:05:32
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param playCount 新增的播放数
+//	@param watchDuration 新增的观看时长，单位秒
+//	@return error
+func IncrVideoPlayStats(ctx context.Context, videoID int64, playCount int64, watchDuration float64) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&Video{}).Where("id = ?", videoID).
+		UpdateColumns(map[string]interface{}{
+			"play_count":     gorm.Expr("play_count + ?", playCount),
+			"watch_duration": gorm.Expr("watch_duration + ?", watchDuration),
+		}).Error
 }
@@ -51,6 +51,27 @@ func GetRelationByUserIDs(ctx context.Context, userID int64, toUserID int64) (*F
 	}
 }
 
+// GetRelationsByUserIDs
+//
+//	@Description: 批量获取用户对多个用户的关注关系
+//	@Date 2026-10-18 15:48:26
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param toUserIDs 被关注用户的用户id列表
+//	@return []*FollowRelation 已存在的关注关系列表
+//	@return error
+func GetRelationsByUserIDs(ctx context.Context, userID int64, toUserIDs []int64) ([]*FollowRelation, error) {
+	res := make([]*FollowRelation, 0)
+	if len(toUserIDs) == 0 {
+		return res, nil
+	}
+
+	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("user_id = ? AND to_user_id IN ?", userID, toUserIDs).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // CreateRelation
 //
 //	@Description: 新增一条用户之间的关注数据
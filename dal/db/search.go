@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+// orderByExpr 带参数的排序表达式，用于按相关度排序
+func orderByExpr(sql string, vars ...interface{}) clause.OrderBy {
+	return clause.OrderBy{Expression: clause.Expr{SQL: sql, Vars: vars, WithoutParentheses: true}}
+}
+
+// SearchUsers
+//
+//	@Description: 在用户名及个人简介中全文检索用户，按相关度倒序返回
+//	@Date 2026-10-18 18:02:37
+//	@param ctx 数据库操作上下文
+//	@param query 布尔模式的全文检索表达式
+//	@param offset 跳过的条数
+//	@param limit 获取的条数
+//	@return []*User 用户列表
+//	@return error
+func SearchUsers(ctx context.Context, query string, offset int, limit int) ([]*User, error) {
+	res := make([]*User, 0)
+	if query == "" {
+		return res, nil
+	}
+
+	match := "MATCH(user_name, signature) AGAINST (? IN BOOLEAN MODE)"
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where(match, query).
+		Clauses(orderByExpr(match+" DESC, id DESC", query)).Offset(offset).Limit(limit).Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchVideos
+//
+//	@Description: 在视频标题中全文检索处理完成且对当前用户可见的视频，按相关度倒序返回
+//	@Date 2026-10-18 18:04:12
+//	@param ctx 数据库操作上下文
+//	@param query 布尔模式的全文检索表达式
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
+//	@param offset 跳过的条数
+//	@param limit 获取的条数
+//	@return []*Video 视频列表
+//	@return error
+func SearchVideos(ctx context.Context, query string, viewerID int64, offset int, limit int) ([]*Video, error) {
+	res := make([]*Video, 0)
+	if query == "" {
+		return res, nil
+	}
+
+	match := "MATCH(title) AGAINST (? IN BOOLEAN MODE)"
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Scopes(visibleTo(viewerID)).Where(match+" AND process_status = ?", query, VideoStatusReady).
+		Clauses(orderByExpr(match+" DESC, id DESC", query)).Offset(offset).Limit(limit).Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// UserSession
+//
+//	@Description: 用户登录会话数据模型，每次登录创建一个会话，同一会话中刷新得到的令牌共用会话 id
+type UserSession struct {
+	ID         string     `gorm:"primarykey;type:varchar(36)" json:"id"`
+	UserID     uint       `gorm:"index:idx_userid_expires;not null" json:"user_id"`
+	Device     string     `gorm:"type:varchar(64)" json:"device"` // 客户端提交的设备名称
+	IP         string     `gorm:"type:varchar(64)" json:"ip"`     // 最近一次使用的 IP
+	UserAgent  string     `gorm:"type:varchar(256)" json:"user_agent"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`                               // 最近一次登录或刷新令牌的时间
+	ExpiresAt  time.Time  `gorm:"index:idx_userid_expires" json:"expires_at"` // 会话内最新刷新令牌的过期时间
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
+
+// CreateUserSession
+//
+//	@Description: 创建登录会话
+//	@Date 2026-10-18 16:20:41
+//	@param ctx 数据库操作上下文
+//	@param session 会话数据
+//	@return error
+func CreateUserSession(ctx context.Context, session *UserSession) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Create(session).Error
+}
+
+// GetActiveUserSessions
+//
+//	@Description: 获取用户未吊销且未过期的全部会话，按最近使用时间倒序排列
+//	@Date 2026-10-18 16:21:09
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@return []*UserSession 会话列表
+//	@return error
+func GetActiveUserSessions(ctx context.Context, userID int64) ([]*UserSession, error) {
+	res := make([]*UserSession, 0)
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).
+		Where("user_id = ? AND expires_at > ? AND revoked_at IS NULL", userID, time.Now()).
+		Order("last_seen_at DESC").Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TouchUserSession
+//
+//	@Description: 刷新令牌时更新会话的最近使用时间、IP 及过期时间，会话已吊销或已过期时不更新
+//	@Date 2026-10-18 16:21:52
+//	@param ctx 数据库操作上下文
+//	@param sessionID 会话id
+//	@param userID 用户id
+//	@param ip 本次使用的 IP，为空时不修改
+//	@param expiresAt 新的刷新令牌的过期时间
+//	@return bool 会话是否有效并已更新
+//	@return error
+func TouchUserSession(ctx context.Context, sessionID string, userID int64, ip string, expiresAt time.Time) (bool, error) {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"last_seen_at": now,
+		"expires_at":   expiresAt,
+	}
+	if ip != "" {
+		updates["ip"] = ip
+	}
+	res := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&UserSession{}).
+		Where("id = ? AND user_id = ? AND expires_at > ? AND revoked_at IS NULL", sessionID, userID, now).
+		Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// RevokeUserSession
+//
+//	@Description: 吊销用户的会话，会话已吊销时保留原吊销时间
+//	@Date 2026-10-18 16:22:30
+//	@param ctx 数据库操作上下文
+//	@param sessionID 会话id
+//	@param userID 用户id，只能吊销自己的会话
+//	@return *UserSession 吊销后的会话，会话不存在或不属于该用户时返回 nil
+//	@return error
+func RevokeUserSession(ctx context.Context, sessionID string, userID int64) (*UserSession, error) {
+	session := new(UserSession)
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND user_id = ?", sessionID, userID).First(session).Error; err != nil {
+			return err
+		}
+		if session.RevokedAt != nil {
+			return nil
+		}
+		now := time.Now()
+		session.RevokedAt = &now
+		return tx.Model(session).Where("revoked_at IS NULL").Update("revoked_at", now).Error
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
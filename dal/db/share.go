@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// VideoShare
+//
+//	@Description: 视频分享记录数据模型
+type VideoShare struct {
+	ID        uint      `gorm:"primarykey"`
+	VideoID   uint      `gorm:"index:idx_videoid;not null" json:"video_id"`
+	UserID    uint      `gorm:"index:idx_userid;not null" json:"user_id"`                      // 分享者id
+	Channel   string    `gorm:"type:varchar(16);default:'';not null" json:"channel,omitempty"` // 分享渠道
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (VideoShare) TableName() string {
+	return "video_shares"
+}
+
+// CreateVideoShare
+//
+//	@Description: 记录一次视频分享，counted 为 true 时累加视频的分享数，仅处理完成且已发布的视频可以分享
+//	@Date 2026-10-18 18:40:16
+//	@param ctx 数据库操作上下文
+//	@param share 分享记录
+//	@param counted 是否累加分享数，同一用户短时间内重复分享时不累加
+//	@return *Video 分享后的视频数据，视频不存在或尚未处理完成时返回 nil
+//	@return error
+func CreateVideoShare(ctx context.Context, share *VideoShare, counted bool) (*Video, error) {
+	video := new(Video)
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&Video{}).Where("id = ? AND process_status = ? AND publish_status = ?", share.VideoID, VideoStatusReady, PublishStatusPublished)
+		if counted {
+			res := query.UpdateColumn("share_count", gorm.Expr("share_count + ?", 1))
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected != 1 {
+				return gorm.ErrRecordNotFound
+			}
+		} else if err := query.First(video).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(share).Error; err != nil {
+			return err
+		}
+		return tx.First(video, share.VideoID).Error
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return video, nil
+}
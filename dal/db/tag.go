@@ -0,0 +1,224 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/cursor"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+// Tag
+//
+//	@Description: 话题数据模型
+type Tag struct {
+	gorm.Model
+	Name       string `gorm:"index:idx_name,unique;type:varchar(80);not null" json:"name,omitempty"`
+	VideoCount uint   `gorm:"default:0;not null" json:"video_count,omitempty"` // 包含的视频数
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// VideoTag
+//
+//	@Description: 视频与话题的关联数据模型
+type VideoTag struct {
+	VideoID   uint      `gorm:"primaryKey;autoIncrement:false" json:"video_id"`
+	TagID     uint      `gorm:"primaryKey;autoIncrement:false;index:idx_tagid" json:"tag_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (VideoTag) TableName() string {
+	return "video_tags"
+}
+
+// TagTrend
+//
+//	@Description: 话题及其热度
+type TagTrend struct {
+	*Tag
+	Score float64
+}
+
+// setVideoTags 在事务中替换视频的话题，并同步话题的视频数量
+func setVideoTags(tx *gorm.DB, videoID int64, names []string) error {
+	// 1. 解除原有话题
+	oldTagIDs := tx.Model(&VideoTag{}).Select("tag_id").Where("video_id = ?", videoID)
+	if err := tx.Model(&Tag{}).Where("id IN (?)", oldTagIDs).Update("video_count", gorm.Expr("video_count - ?", 1)).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("video_id = ?", videoID).Delete(&VideoTag{}).Error; err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	// 2. 创建尚不存在的话题
+	tags := make([]*Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &Tag{Name: name})
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return err
+	}
+	tags = tags[:0]
+	if err := tx.Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return err
+	}
+
+	// 3. 关联新话题
+	videoTags := make([]*VideoTag, 0, len(tags))
+	tagIDs := make([]uint, 0, len(tags))
+	for _, t := range tags {
+		videoTags = append(videoTags, &VideoTag{VideoID: uint(videoID), TagID: t.ID})
+		tagIDs = append(tagIDs, t.ID)
+	}
+	if err := tx.Create(&videoTags).Error; err != nil {
+		return err
+	}
+	return tx.Model(&Tag{}).Where("id IN ?", tagIDs).Update("video_count", gorm.Expr("video_count + ?", 1)).Error
+}
+
+// SetVideoTags
+//
+//	@Description: 替换视频的话题，话题不存在时自动创建
+//	@Date 2026-10-18 17:32:15
+//	@param ctx 数据库操作上下文
+//	@param videoID 视频id
+//	@param names 话题名列表，为空表示清除视频的话题
+//	@return error
+func SetVideoTags(ctx context.Context, videoID int64, names []string) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setVideoTags(tx, videoID, names)
+	})
+}
+
+// GetTagByName
+//
+//	@Description: 根据话题名获取话题
+//	@Date 2026-10-18 17:33:40
+//	@param ctx 数据库操作上下文
+//	@param name 话题名
+//	@return *Tag 话题数据，不存在时返回 nil
+//	@return error
+func GetTagByName(ctx context.Context, name string) (*Tag, error) {
+	tag := new(Tag)
+	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Where("name = ?", name).First(tag).Error; err == nil {
+		return tag, nil
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
+
+// GetVideosByTagID
+//
+//	@Description: 按发布时间倒序获取话题下对当前用户可见的视频
+//	@Date 2026-10-18 17:35:02
+//	@param ctx 数据库操作上下文
+//	@param tagID 话题id
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
+//	@param limit 获取的视频条数
+//	@param c 分页游标，为 nil 时从最新发布的视频开始
+//	@return []*Video 视频列表
+//	@return error
+func GetVideosByTagID(ctx context.Context, tagID int64, viewerID int64, limit int, c *cursor.Cursor) ([]*Video, error) {
+	videos := make([]*Video, 0)
+	err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Model(&Video{}).
+		Joins("JOIN video_tags ON video_tags.video_id = videos.id").
+		Scopes(beforeCursor(c, "videos.created_at", "videos.id"), visibleTo(viewerID)).
+		Where("video_tags.tag_id = ? AND videos.process_status = ?", tagID, VideoStatusReady).
+		Limit(limit).Order("videos.created_at desc, videos.id desc").Find(&videos).Error
+	if err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// GetTrendingTags
+//
+//	@Description: 根据近期发布的视频数及近期获得的点赞数计算话题热度，按热度倒序返回
+//	@Date 2026-10-18 17:38:26
+//	@param ctx 数据库操作上下文
+//	@param since 统计的起始时间
+//	@param publishWeight 每个新发布视频计入的热度
+//	@param favoriteWeight 每个新增点赞计入的热度
+//	@param limit 获取的话题数
+//	@return []*TagTrend 话题及其热度列表
+//	@return error
+func GetTrendingTags(ctx context.Context, since time.Time, publishWeight float64, favoriteWeight float64, limit int) ([]*TagTrend, error) {
+	type tagCount struct {
+		TagID uint
+		Count int64
+	}
+	conn := GetDB().Clauses(dbresolver.Read).WithContext(ctx)
+
+	// 1. 近期发布的视频数
+	var publishes []tagCount
+	err := conn.Model(&VideoTag{}).Select("video_tags.tag_id, COUNT(*) AS count").
+		Joins("JOIN videos ON videos.id = video_tags.video_id").
+		Where("videos.created_at >= ? AND videos.process_status = ? AND videos.publish_status = ? AND videos.deleted_at IS NULL", since, VideoStatusReady, PublishStatusPublished).
+		Group("video_tags.tag_id").Scan(&publishes).Error
+	if err != nil {
+		return nil, err
+	}
+	// 2. 近期获得的点赞数
+	var favorites []tagCount
+	err = conn.Model(&VideoTag{}).Select("video_tags.tag_id, COUNT(*) AS count").
+		Joins("JOIN user_favorite_videos ON user_favorite_videos.video_id = video_tags.video_id").
+		Where("user_favorite_videos.created_at >= ?", since).
+		Group("video_tags.tag_id").Scan(&favorites).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uint]float64)
+	for _, p := range publishes {
+		scores[p.TagID] += publishWeight * float64(p.Count)
+	}
+	for _, f := range favorites {
+		scores[f.TagID] += favoriteWeight * float64(f.Count)
+	}
+	tagIDs := make([]uint, 0, len(scores))
+	for id, score := range scores {
+		if score > 0 {
+			tagIDs = append(tagIDs, id)
+		}
+	}
+	sort.Slice(tagIDs, func(i, j int) bool {
+		if scores[tagIDs[i]] != scores[tagIDs[j]] {
+			return scores[tagIDs[i]] > scores[tagIDs[j]]
+		}
+		return tagIDs[i] < tagIDs[j]
+	})
+	if len(tagIDs) > limit {
+		tagIDs = tagIDs[:limit]
+	}
+
+	// 3. 按热度顺序组装话题
+	res := make([]*TagTrend, 0, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return res, nil
+	}
+	var tags []*Tag
+	if err = conn.Where("id IN ?", tagIDs).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	tagMap := make(map[uint]*Tag, len(tags))
+	for _, t := range tags {
+		tagMap[t.ID] = t
+	}
+	for _, id := range tagIDs {
+		if t, ok := tagMap[id]; ok {
+			res = append(res, &TagTrend{Tag: t, Score: scores[id]})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+// UserTOTP
+//
+//	@Description: 用户两步验证数据模型，确认绑定前 EnabledAt 为空
+type UserTOTP struct {
+	UserID       uint       `gorm:"primarykey;autoIncrement:false" json:"user_id"`
+	Secret       string     `gorm:"type:varchar(256);not null" json:"-"` // 加密后的 TOTP 密钥
+	LastUsedStep int64      `gorm:"default:0;not null" json:"-"`         // 最近一次使用的验证码时间步，用于防止重放
+	CreatedAt    time.Time  `json:"created_at"`
+	EnabledAt    *time.Time `json:"enabled_at,omitempty"`
+}
+
+func (UserTOTP) TableName() string {
+	return "user_totps"
+}
+
+// Enabled 是否已确认绑定
+func (t *UserTOTP) Enabled() bool {
+	return t.EnabledAt != nil
+}
+
+// UserRecoveryCode
+//
+//	@Description: 两步验证的一次性恢复码数据模型，只保存哈希
+type UserRecoveryCode struct {
+	ID       uint       `gorm:"primarykey"`
+	UserID   uint       `gorm:"index:idx_userid_code;not null" json:"user_id"`
+	CodeHash string     `gorm:"index:idx_userid_code;type:varchar(64);not null" json:"-"`
+	UsedAt   *time.Time `json:"used_at,omitempty"`
+}
+
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
+
+// GetUserTOTP
+//
+//	@Description: 获取用户的两步验证数据
+//	@Date 2026-10-18 19:40:12
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@return *UserTOTP 两步验证数据，未绑定时返回 nil
+//	@return error
+func GetUserTOTP(ctx context.Context, userID int64) (*UserTOTP, error) {
+	res := new(UserTOTP)
+	if err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Where("user_id = ?", userID).First(res).Error; err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SaveUserTOTP
+//
+//	@Description: 保存待确认的两步验证密钥及恢复码，覆盖此前未确认的密钥，已确认绑定时不修改
+//	@Date 2026-10-18 19:41:05
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param secret 加密后的密钥
+//	@param codeHashes 恢复码哈希
+//	@return bool 是否已保存，已确认绑定时返回 false
+//	@return error
+func SaveUserTOTP(ctx context.Context, userID int64, secret string, codeHashes []string) (bool, error) {
+	saved := false
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		totp := new(UserTOTP)
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(totp).Error
+		if err == nil && totp.Enabled() {
+			return nil
+		} else if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		totp = &UserTOTP{UserID: uint(userID), Secret: secret, CreatedAt: time.Now()}
+		if err := tx.Save(totp).Error; err != nil {
+			return err
+		}
+		if err := replaceRecoveryCodes(tx, userID, codeHashes); err != nil {
+			return err
+		}
+		saved = true
+		return nil
+	})
+	return saved, err
+}
+
+// replaceRecoveryCodes 删除用户原有的恢复码并写入新的恢复码
+func replaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]*UserRecoveryCode, 0, len(codeHashes))
+	for _, h := range codeHashes {
+		codes = append(codes, &UserRecoveryCode{UserID: uint(userID), CodeHash: h})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return tx.Create(&codes).Error
+}
+
+// UseUserTOTPStep
+//
+//	@Description: 记录已使用的验证码时间步，需大于上次使用的时间步，防止同一验证码被重复使用
+//	@Date 2026-10-18 19:42:27
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param step 验证码的时间步
+//	@param enable 是否同时确认绑定
+//	@return bool 是否记录成功，时间步已被使用时返回 false
+//	@return error
+func UseUserTOTPStep(ctx context.Context, userID int64, step int64, enable bool) (bool, error) {
+	updates := map[string]interface{}{"last_used_step": step}
+	query := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&UserTOTP{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step)
+	if enable {
+		updates["enabled_at"] = time.Now()
+		query = query.Where("enabled_at IS NULL")
+	} else {
+		query = query.Where("enabled_at IS NOT NULL")
+	}
+	res := query.Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// UseUserRecoveryCode
+//
+//	@Description: 使用一次性恢复码，使用后失效
+//	@Date 2026-10-18 19:43:10
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param codeHash 恢复码哈希
+//	@return bool 恢复码是否有效
+//	@return error
+func UseUserRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	res := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteUserTOTP
+//
+//	@Description: 关闭两步验证，删除密钥及恢复码
+//	@Date 2026-10-18 19:43:48
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@return error
+func DeleteUserTOTP(ctx context.Context, userID int64) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&UserTOTP{}).Error
+	})
+}
@@ -0,0 +1,128 @@
+//
+// Package db
+// @Description: 数据库数据库操作业务逻辑
+// @Author hehehhh
+// @Date 2023-01-21 14:33:47
+// @Update
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// 分片上传会话状态
+const (
+	UploadStatusUploading uint = iota // 上传中
+	UploadStatusCompleted             // 已完成，视频记录已创建
+	UploadStatusAborted               // 已取消
+	UploadStatusMerging               // 分片已提交合并，校验或创建视频记录失败时可重试
+)
+
+// UploadSession
+//
+//	@Description: 视频分片上传会话数据模型
+type UploadSession struct {
+	gorm.Model
+	UploadID      string     `gorm:"index:idx_uploadid,unique;type:varchar(255);not null" json:"upload_id"`
+	User          User       `gorm:"foreignkey:UserID" json:"user,omitempty"`
+	UserID        uint       `gorm:"index:idx_userid;not null" json:"user_id"`
+	Title         string     `gorm:"type:varchar(50);not null" json:"title"`
+	Visibility    uint       `gorm:"default:1;not null" json:"visibility"`     // 合并完成后创建视频时使用的可见范围
+	PublishStatus uint       `gorm:"default:1;not null" json:"publish_status"` // 合并完成后创建视频时使用的发布状态
+	PublishAt     *time.Time `json:"publish_at,omitempty"`                     // 定时发布时间
+	ObjectName    string     `gorm:"type:varchar(255);not null" json:"object_name"`
+	FileSize      int64      `gorm:"not null" json:"file_size"`
+	PartSize      int64      `gorm:"not null" json:"part_size"`
+	PartCount     int        `gorm:"not null" json:"part_count"`
+	Status        uint       `gorm:"default:0;not null" json:"status"`
+}
+
+func (UploadSession) TableName() string {
+	return "upload_sessions"
+}
+
+// CreateUploadSession
+//
+//	@Description: 新增一条分片上传会话
+//	@Date 2026-10-18 10:12:31
+//	@param ctx 数据库操作上下文
+//	@param session 上传会话数据
+//	@return error
+func CreateUploadSession(ctx context.Context, session *UploadSession) error {
+	return GetDB().Clauses(dbresolver.Write).WithContext(ctx).Create(session).Error
+}
+
+// GetUploadSessionByUploadID
+//
+//	@Description: 根据 uploadID 获取分片上传会话
+//	@Date 2026-10-18 10:13:05
+//	@param ctx 数据库操作上下文
+//	@param uploadID 上传会话id
+//	@return *UploadSession 上传会话数据
+//	@return error
+func GetUploadSessionByUploadID(ctx context.Context, uploadID string) (*UploadSession, error) {
+	res := new(UploadSession)
+	if err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Where("upload_id = ?", uploadID).First(&res).Error; err == nil {
+		return res, nil
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+}
+
+// UpdateUploadSessionStatus
+//
+//	@Description: 更新分片上传会话状态，仅当会话仍处于 from 状态时生效
+//	@Date 2026-10-18 10:14:47
+//	@param ctx 数据库操作上下文
+//	@param uploadID 上传会话id
+//	@param from 当前的会话状态
+//	@param to 新的会话状态
+//	@return bool 是否更新成功
+//	@return error
+func UpdateUploadSessionStatus(ctx context.Context, uploadID string, from, to uint) (bool, error) {
+	res := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&UploadSession{}).
+		Where("upload_id = ? AND status = ?", uploadID, from).
+		Update("status", to)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// CompleteUploadSession
+//
+//	@Description: 将合并中的分片上传会话标记为完成，同时新增视频记录及其处理任务，会话已被其他请求完成时不创建
+//	@Date 2026-10-18 16:20:37
+//	@param ctx 数据库操作上下文
+//	@param uploadID 上传会话id
+//	@param video 视频数据
+//	@param status 初始处理状态
+//	@param tags 标题中的话题名列表
+//	@return *PublishJob 处理任务数据，会话已结束时为 nil
+//	@return error
+func CompleteUploadSession(ctx context.Context, uploadID string, video *Video, status uint, tags []string) (*PublishJob, error) {
+	var job *PublishJob
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&UploadSession{}).
+			Where("upload_id = ? AND status = ?", uploadID, UploadStatusMerging).
+			Update("status", UploadStatusCompleted)
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		var err error
+		job, err = createPublishJob(tx, video, status, tags)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
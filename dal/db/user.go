@@ -12,6 +12,7 @@ import (
 	"context"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/plugin/dbresolver"
 )
 
@@ -20,17 +21,17 @@ import (
 //	@Description: 用户数据模型
 type User struct {
 	gorm.Model
-	UserName        string  `gorm:"index:idx_username,unique;type:varchar(40);not null" json:"name,omitempty"`
+	UserName        string  `gorm:"index:idx_username,unique;index:idx_user_fulltext,class:FULLTEXT,option:WITH PARSER ngram;type:varchar(40);not null" json:"name,omitempty"`
 	Password        string  `gorm:"type:varchar(256);not null" json:"password,omitempty"`
 	FavoriteVideos  []Video `gorm:"many2many:user_favorite_videos" json:"favorite_videos,omitempty"`
-	FollowingCount  uint    `gorm:"default:0;not null" json:"follow_count,omitempty"`                                                           // 关注总数
-	FollowerCount   uint    `gorm:"default:0;not null" json:"follower_count,omitempty"`                                                         // 粉丝总数
-	Avatar          string  `gorm:"type:varchar(256)" json:"avatar,omitempty"`                                                                  // 用户头像
-	BackgroundImage string  `gorm:"column:background_image;type:varchar(256);default:default_background.jpg" json:"background_image,omitempty"` // 用户个人页顶部大图
-	WorkCount       uint    `gorm:"default:0;not null" json:"work_count,omitempty"`                                                             // 作品数
-	FavoriteCount   uint    `gorm:"default:0;not null" json:"favorite_count,omitempty"`                                                         // 喜欢数
-	TotalFavorited  uint    `gorm:"default:0;not null" json:"total_favorited,omitempty"`                                                        // 获赞总量
-	Signature       string  `gorm:"type:varchar(256)" json:"signature,omitempty"`                                                               // 个人简介
+	FollowingCount  uint    `gorm:"default:0;not null" json:"follow_count,omitempty"`                                                             // 关注总数
+	FollowerCount   uint    `gorm:"default:0;not null" json:"follower_count,omitempty"`                                                           // 粉丝总数
+	Avatar          string  `gorm:"type:varchar(256)" json:"avatar,omitempty"`                                                                    // 用户头像
+	BackgroundImage string  `gorm:"column:background_image;type:varchar(256);default:default_background.jpg" json:"background_image,omitempty"`   // 用户个人页顶部大图
+	WorkCount       uint    `gorm:"default:0;not null" json:"work_count,omitempty"`                                                               // 作品数
+	FavoriteCount   uint    `gorm:"default:0;not null" json:"favorite_count,omitempty"`                                                           // 喜欢数
+	TotalFavorited  uint    `gorm:"default:0;not null" json:"total_favorited,omitempty"`                                                          // 获赞总量
+	Signature       string  `gorm:"index:idx_user_fulltext,class:FULLTEXT,option:WITH PARSER ngram;type:varchar(256)" json:"signature,omitempty"` // 个人简介
 }
 
 func (User) TableName() string {
@@ -142,3 +143,85 @@ func GetPasswordByUsername(ctx context.Context, userName string) (*User, error)
 		return nil, err
 	}
 }
+
+// updateUserImage
+//
+//	@Description: 更新用户的头像或背景图对象名，并返回更新前的对象名
+//	@Date 2026-10-18 10:12:40
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param column 列名，avatar 或 background_image
+//	@param objectName 新的对象名
+//	@return string 更新前的对象名，用户不存在时返回空字符串
+//	@return bool 用户是否存在
+//	@return error
+func updateUserImage(ctx context.Context, userID int64, column, objectName string) (string, bool, error) {
+	var old string
+	found := false
+	err := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		usr := new(User)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", column).First(usr, userID).Error; err == gorm.ErrRecordNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		found = true
+		if column == "avatar" {
+			old = usr.Avatar
+		} else {
+			old = usr.BackgroundImage
+		}
+		return tx.Model(usr).Update(column, objectName).Error
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return old, found, nil
+}
+
+// UpdateUserAvatar
+//
+//	@Description: 更新用户头像，并返回原头像的对象名
+//	@Date 2026-10-18 10:13:05
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param avatar 新头像的对象名
+//	@return string 原头像的对象名
+//	@return bool 用户是否存在
+//	@return error
+func UpdateUserAvatar(ctx context.Context, userID int64, avatar string) (string, bool, error) {
+	return updateUserImage(ctx, userID, "avatar", avatar)
+}
+
+// UpdateUserBackgroundImage
+//
+//	@Description: 更新用户个人页顶部大图，并返回原背景图的对象名
+//	@Date 2026-10-18 10:13:21
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param backgroundImage 新背景图的对象名
+//	@return string 原背景图的对象名
+//	@return bool 用户是否存在
+//	@return error
+func UpdateUserBackgroundImage(ctx context.Context, userID int64, backgroundImage string) (string, bool, error) {
+	return updateUserImage(ctx, userID, "background_image", backgroundImage)
+}
+
+// UpdateUserPassword
+//
+//	@Description: 更新用户的密码哈希，仅在当前哈希与 oldHash 一致时更新，避免覆盖并发修改的密码
+//	@Date 2026-10-18 13:26:48
+//	@param ctx 数据库操作上下文
+//	@param userID 用户id
+//	@param oldHash 更新前的密码哈希
+//	@param newHash 新的密码哈希
+//	@return bool 是否更新成功
+//	@return error
+func UpdateUserPassword(ctx context.Context, userID int64, oldHash, newHash string) (bool, error) {
+	res := GetDB().Clauses(dbresolver.Write).WithContext(ctx).Model(&User{}).
+		Where("id = ? AND password = ?", userID, oldHash).Update("password", newHash)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
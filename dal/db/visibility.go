@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// 视频可见范围
+const (
+	VisibilityPublic    uint = iota + 1 // 所有人可见
+	VisibilityFollowers                 // 关注作者的用户可见
+	VisibilityFriends                   // 与作者互相关注的用户可见
+	VisibilityPrivate                   // 仅作者本人可见
+)
+
+// ValidVisibility 判断可见范围取值是否合法
+func ValidVisibility(visibility uint) bool {
+	return visibility >= VisibilityPublic && visibility <= VisibilityPrivate
+}
+
+// visibleTo
+//
+//	@Description: 仅保留已发布且对指定用户可见的视频，查询中的视频表需以 videos 命名
+//	@Date 2026-10-18 19:05:12
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值，仅能查看公开视频
+//	@return func(*gorm.DB) *gorm.DB 查询条件
+func visibleTo(viewerID int64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// 草稿及定时发布的视频仅出现在作者的草稿列表中
+		db = db.Where("videos.publish_status = ?", PublishStatusPublished)
+		if viewerID <= 0 {
+			return db.Where("videos.visibility = ?", VisibilityPublic)
+		}
+		// relations 中 user_id 关注了 to_user_id
+		follows := "EXISTS (SELECT 1 FROM relations WHERE relations.user_id = ? AND relations.to_user_id = videos.author_id)"
+		followed := "EXISTS (SELECT 1 FROM relations WHERE relations.user_id = videos.author_id AND relations.to_user_id = ?)"
+		return db.Where("(videos.visibility = ? OR videos.author_id = ? OR (videos.visibility = ? AND "+follows+") OR (videos.visibility = ? AND "+follows+" AND "+followed+"))",
+			VisibilityPublic, viewerID, VisibilityFollowers, viewerID, VisibilityFriends, viewerID, viewerID)
+	}
+}
+
+// CanViewVideo
+//
+//	@Description: 根据视频的发布状态、可见范围及用户与作者的关注关系，判断用户能否查看该视频，未发布的视频仅作者可见
+//	@Date 2026-10-18 19:07:30
+//	@param ctx 数据库操作上下文
+//	@param video 视频数据
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
+//	@return bool 是否可见
+//	@return error
+func CanViewVideo(ctx context.Context, video *Video, viewerID int64) (bool, error) {
+	if viewerID > 0 && int64(video.AuthorID) == viewerID {
+		return true, nil
+	}
+	if video.PublishStatus != PublishStatusPublished {
+		return false, nil
+	}
+	if video.Visibility == VisibilityPublic {
+		return true, nil
+	}
+	if viewerID <= 0 || video.Visibility == VisibilityPrivate {
+		return false, nil
+	}
+
+	conn := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Model(&FollowRelation{})
+	var count int64
+	if err := conn.Where("user_id = ? AND to_user_id = ?", viewerID, video.AuthorID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count == 0 || video.Visibility == VisibilityFollowers {
+		return count > 0, nil
+	}
+	// 好友可见还需作者关注了当前用户
+	conn = GetDB().Clauses(dbresolver.Read).WithContext(ctx).Model(&FollowRelation{})
+	if err := conn.Where("user_id = ? AND to_user_id = ?", video.AuthorID, viewerID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetVisibleVideoListByIDs
+//
+//	@Description: 根据视频id列表获取对指定用户可见的视频
+//	@Date 2026-10-18 19:10:48
+//	@param ctx 数据库操作上下文
+//	@param videoIDs 视频id列表
+//	@param viewerID 当前用户id，未登录时传入不大于 0 的值
+//	@return []*Video 视频数据列表
+//	@return error
+func GetVisibleVideoListByIDs(ctx context.Context, videoIDs []int64, viewerID int64) ([]*Video, error) {
+	res := make([]*Video, 0)
+	if len(videoIDs) == 0 {
+		return res, nil
+	}
+
+	if err := GetDB().Clauses(dbresolver.Read).WithContext(ctx).Scopes(visibleTo(viewerID)).Where("videos.id IN ?", videoIDs).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
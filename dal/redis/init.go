@@ -22,6 +22,7 @@ var (
 	redisHelper   *RedisHelper
 	FavoriteMutex *redsync.Mutex
 	RelationMutex *redsync.Mutex
+	PlayMutex     *redsync.Mutex
 )
 
 type RedisHelper struct {
@@ -81,6 +82,7 @@ func init() {
 	// 开启定时同步至数据库
 	GoCronFavorite()
 	GoCronRelation()
+	GoCronPlay()
 	zapLogger.Info("MySQL synchronization is enabled.")
 
 	// Redis锁
@@ -91,4 +93,5 @@ func init() {
 	// Obtain a new mutex by using the same name for all instances wanting the same lock.
 	FavoriteMutex = rs.NewMutex("mutex-favorite")
 	RelationMutex = rs.NewMutex("mutex-relation")
+	PlayMutex = rs.NewMutex("mutex-play")
 }
@@ -0,0 +1,158 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+/*
+登录失败计数，按用户名、IP及两步验证分别统计：
+1. login::fail::scope::id 为统计窗口内的连续失败次数，包括正在校验的尝试，窗口从第一次失败开始计算，scope 为 user、ip 或 mfa
+2. login::block::scope::id 存在时禁止登录，值为“限制类型:尝试id”，过期时间即剩余的等待时间，尝试id 标识设置该限制的尝试
+*/
+
+// 登录限制类型
+const (
+	LoginBlockBackoff = "backoff" // 失败后的退避等待
+	LoginBlockLocked  = "locked"  // 失败次数过多，临时锁定
+)
+
+// 登录失败的统计维度
+const (
+	LoginScopeUser = "user"
+	LoginScopeIP   = "ip"
+	LoginScopeMFA  = "mfa" // 两步验证码，按用户id统计
+)
+
+func loginFailKey(scope, id string) string {
+	return fmt.Sprintf("login::fail::%s::%s", scope, id)
+}
+
+func loginBlockKey(scope, id string) string {
+	return fmt.Sprintf("login::block::%s::%s", scope, id)
+}
+
+// LoginLimit 登录尝试的统计对象及其退避策略
+type LoginLimit struct {
+	Scope string
+	ID    string
+	// Delays 第 i+1 次失败后需等待的时间，失败次数超过长度时按最后一项计算
+	Delays []time.Duration
+	// Locked 为 true 时最后一项为锁定时长
+	Locked bool
+}
+
+// LoginBlock 登录限制，Kind 为空时不受限制
+type LoginBlock struct {
+	Scope      string
+	ID         string
+	Kind       string
+	RetryAfter time.Duration
+}
+
+// reserveLoginScript 先检查全部统计对象的限制，受限时返回等待时间最长的限制且不计数；
+// 否则各统计对象的失败次数加一，并按计数后的失败次数提前设置本次尝试失败后的限制，
+// 并发的尝试因此只能依次通过，不会在校验结果写入前绕过限制。
+// KEYS 依次为每个统计对象的 block、fail 键，ARGV[1] 为统计窗口（毫秒），ARGV[2] 为尝试id，
+// 之后依次为每个统计对象的等待时间个数、是否锁定及各等待时间（毫秒）
+var reserveLoginScript = redis.NewScript(`
+local blocked, kind, ttl = 0, "", 0
+for i = 1, #KEYS, 2 do
+	local t = redis.call("PTTL", KEYS[i])
+	if t > ttl then
+		blocked, kind, ttl = (i + 1) / 2, string.match(redis.call("GET", KEYS[i]), "^[^:]*"), t
+	end
+end
+if blocked > 0 then
+	return {blocked, kind, ttl}
+end
+local result = {0}
+local pos = 3
+for i = 1, #KEYS, 2 do
+	local failures = redis.call("INCR", KEYS[i + 1])
+	if redis.call("PTTL", KEYS[i + 1]) < 0 then
+		redis.call("PEXPIRE", KEYS[i + 1], ARGV[1])
+	end
+	local n = tonumber(ARGV[pos])
+	local delay, kind = 0, ""
+	if n > 0 then
+		delay = tonumber(ARGV[pos + 1 + math.min(failures, n)])
+		if delay > 0 then
+			kind = "` + LoginBlockBackoff + `"
+			if ARGV[pos + 1] == "1" and failures >= n then
+				kind = "` + LoginBlockLocked + `"
+			end
+			redis.call("SET", KEYS[i], kind .. ":" .. ARGV[2], "PX", delay)
+		end
+	end
+	table.insert(result, failures)
+	table.insert(result, kind)
+	table.insert(result, delay)
+	pos = pos + 2 + n
+end
+return result
+`)
+
+// ReserveLoginAttempt 校验密码或验证码前原子地检查限制并以 attemptID 预占一次尝试。
+// 任一统计对象受限时返回 blocked，否则返回各统计对象在本次尝试失败后生效的限制及失败次数，
+// 限制已提前设置，校验成功后通过 ResetLoginFailure 或 ReleaseLoginAttempt 撤销
+func ReserveLoginAttempt(ctx context.Context, window time.Duration, attemptID string, limits ...LoginLimit) (blocked *LoginBlock, pending []LoginBlock, failures []int64, err error) {
+	keys := make([]string, 0, 2*len(limits))
+	args := []interface{}{window.Milliseconds(), attemptID}
+	for _, l := range limits {
+		keys = append(keys, loginBlockKey(l.Scope, l.ID), loginFailKey(l.Scope, l.ID))
+		locked := 0
+		if l.Locked {
+			locked = 1
+		}
+		args = append(args, len(l.Delays), locked)
+		for _, d := range l.Delays {
+			args = append(args, d.Milliseconds())
+		}
+	}
+	values, err := reserveLoginScript.Run(ctx, GetRedisHelper(), keys, args...).Slice()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if index, _ := values[0].(int64); index > 0 {
+		l := limits[index-1]
+		kind, _ := values[1].(string)
+		ttl, _ := values[2].(int64)
+		return &LoginBlock{Scope: l.Scope, ID: l.ID, Kind: kind, RetryAfter: time.Duration(ttl) * time.Millisecond}, nil, nil, nil
+	}
+	pending = make([]LoginBlock, len(limits))
+	failures = make([]int64, len(limits))
+	for i, l := range limits {
+		failures[i], _ = values[1+3*i].(int64)
+		kind, _ := values[2+3*i].(string)
+		delay, _ := values[3+3*i].(int64)
+		pending[i] = LoginBlock{Scope: l.Scope, ID: l.ID, Kind: kind, RetryAfter: time.Duration(delay) * time.Millisecond}
+	}
+	return nil, pending, failures, nil
+}
+
+// releaseLoginScript 失败次数大于 0 时减一，并删除由该尝试设置的限制，其他尝试设置的限制保留。
+// KEYS 为 fail、block 键，ARGV[1] 为尝试id
+var releaseLoginScript = redis.NewScript(`
+if tonumber(redis.call("GET", KEYS[1]) or "0") > 0 then
+	redis.call("DECR", KEYS[1])
+end
+local block = redis.call("GET", KEYS[2])
+if block and string.sub(block, -#ARGV[1] - 1) == ":" .. ARGV[1] then
+	redis.call("DEL", KEYS[2])
+end
+return 0
+`)
+
+// ReleaseLoginAttempt 校验成功或未能完成校验时撤销 attemptID 预占的尝试，同时解除该尝试提前设置的限制
+func ReleaseLoginAttempt(ctx context.Context, scope, id, attemptID string) error {
+	return releaseLoginScript.Run(ctx, GetRedisHelper(), []string{loginFailKey(scope, id), loginBlockKey(scope, id)}, attemptID).Err()
+}
+
+// ResetLoginFailure 登录成功后清除失败次数及登录限制
+func ResetLoginFailure(ctx context.Context, scope, id string) error {
+	return GetRedisHelper().Del(ctx, loginFailKey(scope, id), loginBlockKey(scope, id)).Err()
+}
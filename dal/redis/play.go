@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/gocron"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+)
+
+// PlayDedupWindow 同一用户在该时间内重复播放同一视频只计一次播放，观看时长仍然累加
+const PlayDedupWindow = 30 * time.Second
+
+type PlayCache struct {
+	VideoID       uint    `json:"video_id"`
+	UserID        uint    `json:"user_id"`        // 未登录用户为0，不做去重
+	WatchDuration float64 `json:"watch_duration"` // 本次观看时长，单位秒
+	CreatedAt     uint    `json:"created_at"`
+}
+
+/*
+* UpdatePlay
+* 1. 使用哈希表聚合视频的播放数据，Key为video::vid::play，count为播放数，duration为观看时长
+* 2. 登录用户使用play::video::vid::user::uid记录最近一次播放，在PlayDedupWindow内不重复计数
+ */
+func UpdatePlay(ctx context.Context, play *PlayCache) error {
+	counted := true
+	if play.UserID != 0 {
+		keyViewer := fmt.Sprintf("play::video::%d::user::%d", play.VideoID, play.UserID)
+		ok, err := GetRedisHelper().SetNX(ctx, keyViewer, play.CreatedAt, PlayDedupWindow).Result()
+		if err != nil {
+			zapLogger.Errorln(err.Error())
+			return err
+		}
+		counted = ok
+	}
+
+	keyPlay := fmt.Sprintf("video::%d::play", play.VideoID)
+	pipe := GetRedisHelper().TxPipeline()
+	if counted {
+		pipe.HIncrBy(ctx, keyPlay, "count", 1)
+	}
+	pipe.HIncrByFloat(ctx, keyPlay, "duration", play.WatchDuration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		zapLogger.Errorln(err.Error())
+		return err
+	}
+	return nil
+}
+
+// flushPlay 将一条待同步的播放数据写入数据库，写入成功后删除。写入失败时保留，等待下次同步
+func flushPlay(ctx context.Context, key string) error {
+	res, err := GetRedisHelper().HGetAll(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	videoID, err := strconv.ParseInt(strings.Split(key, "::")[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	count, _ := strconv.ParseInt(res["count"], 10, 64)
+	duration, _ := strconv.ParseFloat(res["duration"], 64)
+	if count != 0 || duration != 0 {
+		if err = db.IncrVideoPlayStats(ctx, videoID, count, duration); err != nil {
+			return err
+		}
+	}
+	return GetRedisHelper().Del(ctx, key).Err()
+}
+
+// PlayMoveToDB 将 Redis 中聚合的播放数据同步至数据库。
+// 同步前先将 video::vid::play 重命名为 video::vid::play::f，同步期间新的播放数据写入新的 Key，不会丢失
+func PlayMoveToDB() error {
+	logger := zap.InitLogger()
+
+	ctx := context.Background()
+	// 多个实例同时同步时，避免重复处理同一条待同步数据
+	if err := LockByMutex(ctx, PlayMutex); err != nil {
+		logger.Errorf("lock failed: %s", err.Error())
+		return err
+	}
+	defer UnlockByMutex(ctx, PlayMutex)
+
+	// 先处理上一轮未同步完成的数据
+	keys, err := getKeys(ctx, "video::*::play::f")
+	if err != nil {
+		logger.Errorln(err)
+		return err
+	}
+	for _, key := range keys {
+		if err = flushPlay(ctx, key); err != nil {
+			logger.Errorln(err.Error())
+			return err
+		}
+	}
+
+	keys, err = getKeys(ctx, "video::*::play")
+	if err != nil {
+		logger.Errorln(err)
+		return err
+	}
+	for _, key := range keys {
+		// 待同步数据已存在时跳过，留到下一轮
+		ok, err := GetRedisHelper().RenameNX(ctx, key, key+"::f").Result()
+		if err != nil || !ok {
+			continue
+		}
+		if err = flushPlay(ctx, key+"::f"); err != nil {
+			logger.Errorln(err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+func GoCronPlay() {
+	s := gocron.NewSchedule()
+	s.Every(frequency).Tag("playRedis").Seconds().Do(PlayMoveToDB)
+	s.StartAsync()
+}
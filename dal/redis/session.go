@@ -0,0 +1,49 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/redis/go-redis/v9"
+)
+
+/*
+用户的登录会话列表缓存，键为session::user::uid，值为未吊销会话列表的JSON。
+会话创建、刷新及吊销时删除缓存，下次查询时从数据库重新加载。
+*/
+
+func sessionsKey(userID int64) string {
+	return fmt.Sprintf("session::user::%d", userID)
+}
+
+// GetUserSessions 获取缓存的会话列表，缓存不存在时返回 nil
+func GetUserSessions(ctx context.Context, userID int64) ([]*db.UserSession, error) {
+	val, err := GetRedisHelper().Get(ctx, sessionsKey(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	sessions := make([]*db.UserSession, 0)
+	if err := json.Unmarshal(val, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// SetUserSessions 缓存会话列表
+func SetUserSessions(ctx context.Context, userID int64, sessions []*db.UserSession, expireTime time.Duration) error {
+	val, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+	return GetRedisHelper().Set(ctx, sessionsKey(userID), val, expireTime).Err()
+}
+
+// DeleteUserSessions 删除会话列表缓存
+func DeleteUserSessions(ctx context.Context, userID int64) error {
+	return GetRedisHelper().Del(ctx, sessionsKey(userID)).Err()
+}
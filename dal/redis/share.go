@@ -0,0 +1,18 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/*
+视频分享计数去重，键为share::video::vid::user::uid。
+同一用户在窗口期内重复分享同一视频只累加一次分享数，分享记录仍然全部保存。
+*/
+
+// MarkVideoShared 记录用户分享了视频，返回本次分享是否计入分享数，窗口期内已分享过时返回 false
+func MarkVideoShared(ctx context.Context, videoID, userID int64, window time.Duration) (bool, error) {
+	key := fmt.Sprintf("share::video::%d::user::%d", videoID, userID)
+	return GetRedisHelper().SetNX(ctx, key, time.Now().Unix(), window).Result()
+}
@@ -0,0 +1,41 @@
+package assembler
+
+import (
+	"context"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+)
+
+// Users 批量加载当前用户的关注状态，按原顺序组装用户列表。
+// viewerID 为当前用户id，未登录时传入 -1
+func Users(ctx context.Context, users []*db.User, viewerID int64) ([]*user.User, error) {
+	res := make([]*user.User, 0, len(users))
+	if len(users) == 0 {
+		return res, nil
+	}
+
+	follows := make(map[uint]bool)
+	if viewerID > 0 {
+		userIDs := make([]int64, 0, len(users))
+		for _, u := range users {
+			userIDs = append(userIDs, int64(u.ID))
+		}
+		relations, err := db.GetRelationsByUserIDs(ctx, viewerID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range relations {
+			follows[r.ToUserID] = true
+		}
+	}
+
+	for _, u := range users {
+		author, err := Author(u, follows[u.ID])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, author)
+	}
+	return res, nil
+}
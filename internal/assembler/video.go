@@ -0,0 +1,170 @@
+// Package assembler 将数据库中的视频数据批量组装为 RPC 返回的视频列表，供 Feed、发布列表及喜欢列表共用
+package assembler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/internal/tool"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/video"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/minio"
+)
+
+// PlayURL 获取视频的播放链接，转码完成的视频返回带签名的 HLS 主播放列表链接，否则返回原视频的访问链接
+func PlayURL(v *db.Video) (string, error) {
+	if v.HlsUrl != "" {
+		return minio.HLSPlaylistURL(minio.VideoBucketName, v.HlsUrl)
+	}
+	return minio.MediaURL(minio.VideoBucketName, v.PlayUrl)
+}
+
+// CoverThumbnails 获取封面各尺寸缩略图的访问链接
+func CoverThumbnails(v *db.Video) ([]*video.CoverThumbnail, error) {
+	thumbnails := make([]*video.CoverThumbnail, 0)
+	if v.CoverThumbs == "" {
+		return thumbnails, nil
+	}
+	for _, w := range strings.Split(v.CoverThumbs, ",") {
+		width, err := strconv.Atoi(w)
+		if err != nil {
+			return nil, err
+		}
+		url, err := minio.MediaURL(minio.CoverBucketName, tool.ThumbnailName(v.CoverUrl, width))
+		if err != nil {
+			return nil, err
+		}
+		thumbnails = append(thumbnails, &video.CoverThumbnail{Width: int32(width), Url: url})
+	}
+	return thumbnails, nil
+}
+
+// Author 组装视频作者信息
+func Author(u *db.User, isFollow bool) (*user.User, error) {
+	avatarUrl, err := minio.MediaURL(minio.AvatarBucketName, u.Avatar)
+	if err != nil {
+		return nil, err
+	}
+	backgroundUrl, err := minio.MediaURL(minio.BackgroundImageBucketName, u.BackgroundImage)
+	if err != nil {
+		return nil, err
+	}
+	return &user.User{
+		Id:              int64(u.ID),
+		Name:            u.UserName,
+		FollowCount:     int64(u.FollowingCount),
+		FollowerCount:   int64(u.FollowerCount),
+		IsFollow:        isFollow,
+		Avatar:          avatarUrl,
+		BackgroundImage: backgroundUrl,
+		Signature:       u.Signature,
+		TotalFavorited:  int64(u.TotalFavorited),
+		WorkCount:       int64(u.WorkCount),
+		FavoriteCount:   int64(u.FavoriteCount),
+	}, nil
+}
+
+// Videos 批量加载作者、关注及点赞状态，按原顺序组装视频列表。
+// viewerID 为当前用户id，未登录时传入 -1；作者不存在的视频会被跳过
+func Videos(ctx context.Context, videos []*db.Video, viewerID int64) ([]*video.Video, error) {
+	res := make([]*video.Video, 0, len(videos))
+	if len(videos) == 0 {
+		return res, nil
+	}
+
+	videoIDs := make([]int64, 0, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	seen := make(map[int64]struct{})
+	for _, v := range videos {
+		videoIDs = append(videoIDs, int64(v.ID))
+		if _, ok := seen[int64(v.AuthorID)]; !ok {
+			seen[int64(v.AuthorID)] = struct{}{}
+			authorIDs = append(authorIDs, int64(v.AuthorID))
+		}
+	}
+
+	// 1. 批量获取作者
+	users, err := db.GetUsersByIDs(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	authors := make(map[uint]*db.User, len(users))
+	for _, u := range users {
+		authors[u.ID] = u
+	}
+
+	// 2. 批量获取当前用户的关注及点赞状态
+	follows := make(map[uint]bool)
+	favorites := make(map[uint]bool)
+	if viewerID > 0 {
+		relations, err := db.GetRelationsByUserIDs(ctx, viewerID, authorIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range relations {
+			follows[r.ToUserID] = true
+		}
+		favoriteRelations, err := db.GetFavoriteVideoRelationsByUserVideoIDs(ctx, viewerID, videoIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range favoriteRelations {
+			favorites[f.VideoID] = true
+		}
+	}
+
+	// 3. 组装视频，同一作者只组装一次
+	authorCache := make(map[uint]*user.User, len(authors))
+	for _, v := range videos {
+		u, ok := authors[v.AuthorID]
+		if !ok {
+			continue
+		}
+		author, ok := authorCache[v.AuthorID]
+		if !ok {
+			if author, err = Author(u, follows[v.AuthorID]); err != nil {
+				return nil, err
+			}
+			authorCache[v.AuthorID] = author
+		}
+		playUrl, err := PlayURL(v)
+		if err != nil {
+			return nil, err
+		}
+		coverUrl, err := minio.MediaURL(minio.CoverBucketName, v.CoverUrl)
+		if err != nil {
+			return nil, err
+		}
+		thumbnails, err := CoverThumbnails(v)
+		if err != nil {
+			return nil, err
+		}
+		var publishAt int64
+		if v.PublishAt != nil {
+			publishAt = v.PublishAt.UnixMilli()
+		}
+		res = append(res, &video.Video{
+			Id:              int64(v.ID),
+			Author:          author,
+			PlayUrl:         playUrl,
+			CoverUrl:        coverUrl,
+			FavoriteCount:   int64(v.FavoriteCount),
+			CommentCount:    int64(v.CommentCount),
+			PlayCount:       int64(v.PlayCount),
+			Visibility:      int32(v.Visibility),
+			PublishStatus:   int32(v.PublishStatus),
+			PublishAt:       publishAt,
+			ShareCount:      int64(v.ShareCount),
+			IsFavorite:      favorites[v.ID],
+			Title:           v.Title,
+			Duration:        v.Duration,
+			Width:           int32(v.Width),
+			Height:          int32(v.Height),
+			Codec:           v.Codec,
+			CoverThumbnails: thumbnails,
+		})
+	}
+	return res, nil
+}
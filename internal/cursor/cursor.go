@@ -0,0 +1,70 @@
+// Package cursor 列表分页游标，按 (created_at, id) 倒序进行键集分页，对客户端不透明
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCursor 游标格式错误
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor 分页位置，下一页从该位置之后（更早的记录）开始
+type Cursor struct {
+	CreatedAt int64 // 记录的创建时间，毫秒时间戳
+	ID        int64 // 记录id，创建时间相同时用于确定先后
+	// Offset 非按时间排序的列表（如推荐）从该位置开始选取候选窗口，Offset 为窗口内已返回的条数
+	Offset int
+}
+
+// Encode 将游标编码为不透明的字符串
+func Encode(c *Cursor) string {
+	if c == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%d:%d", c.CreatedAt, c.ID)
+	if c.Offset > 0 {
+		s += ":" + strconv.Itoa(c.Offset)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// Decode 解码游标字符串，空字符串表示从最新的记录开始，返回 nil
+func Decode(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	fields := strings.Split(string(data), ":")
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, ErrInvalidCursor
+	}
+	c := new(Cursor)
+	if c.CreatedAt, err = strconv.ParseInt(fields[0], 10, 64); err != nil || c.CreatedAt <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	if c.ID, err = strconv.ParseInt(fields[1], 10, 64); err != nil || c.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	if len(fields) == 3 {
+		if c.Offset, err = strconv.Atoi(fields[2]); err != nil || c.Offset <= 0 {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return c, nil
+}
+
+// FromTime 由时间戳构造游标，下一页仅包含早于该时间的记录，用于兼容只传入 latest_time 的旧版请求。
+// timestamp 不大于 0 时返回 nil
+func FromTime(timestamp int64) *Cursor {
+	if timestamp <= 0 {
+		return nil
+	}
+	return &Cursor{CreatedAt: timestamp}
+}
@@ -0,0 +1,28 @@
+package cursor
+
+import "testing"
+
+func TestEncodeDecode(t *testing.T) {
+	c := &Cursor{CreatedAt: 1674288000123, ID: 42}
+	got, err := Decode(Encode(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *c {
+		t.Fatalf("got %+v, want %+v", got, c)
+	}
+
+	c = &Cursor{CreatedAt: 1674288000123, ID: 42, Offset: 30}
+	if got, err = Decode(Encode(c)); err != nil || *got != *c {
+		t.Fatalf("cursor with offset: got %+v, %v", got, err)
+	}
+
+	if got, err = Decode(""); err != nil || got != nil {
+		t.Fatalf("empty cursor: got %+v, %v", got, err)
+	}
+	for _, s := range []string{"!!", Encode(&Cursor{CreatedAt: 1}), "MTIz", "MToxOjA", "MToxOjE6MQ"} {
+		if _, err = Decode(s); err != ErrInvalidCursor {
+			t.Fatalf("Decode(%q): got %v, want ErrInvalidCursor", s, err)
+		}
+	}
+}
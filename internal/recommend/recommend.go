@@ -0,0 +1,95 @@
+// Package recommend 基于关注关系、点赞、评论数及发布时间对 Feed 候选视频进行个性化排序
+package recommend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/dal/db"
+)
+
+// Candidate 候选视频及当前用户相关的排序信号
+type Candidate struct {
+	Video             *db.Video
+	FollowingAuthor   bool    // 当前用户关注了作者
+	Favorited         bool    // 当前用户已点赞该视频
+	FolloweeFavorites int64   // 当前用户关注的人中点赞该视频的人数
+	Score             float64 // 排序得分，由 Ranker 写入
+}
+
+// Ranker 排序器，将候选视频按推荐程度从高到低排序
+type Ranker interface {
+	Rank(ctx context.Context, viewerID int64, candidates []*Candidate) ([]*Candidate, error)
+}
+
+var (
+	mu      sync.RWMutex
+	rankers = make(map[string]Ranker)
+)
+
+// Register 注册排序器，同名排序器会被覆盖
+func Register(name string, r Ranker) {
+	mu.Lock()
+	defer mu.Unlock()
+	rankers[name] = r
+}
+
+// Get 获取已注册的排序器
+func Get(name string) (Ranker, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	r, ok := rankers[name]
+	return r, ok
+}
+
+// LoadCandidates 批量加载当前用户的关注及点赞信号，组装候选视频。
+// viewerID 为当前用户id，未登录时传入 -1，此时仅保留视频自身的信号
+func LoadCandidates(ctx context.Context, videos []*db.Video, viewerID int64) ([]*Candidate, error) {
+	candidates := make([]*Candidate, 0, len(videos))
+	for _, v := range videos {
+		candidates = append(candidates, &Candidate{Video: v})
+	}
+	if viewerID <= 0 || len(videos) == 0 {
+		return candidates, nil
+	}
+
+	videoIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		videoIDs = append(videoIDs, int64(v.ID))
+	}
+
+	// 1. 当前用户的关注列表
+	relations, err := db.GetFollowingListByUserID(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	followings := make(map[uint]bool, len(relations))
+	followingIDs := make([]int64, 0, len(relations))
+	for _, r := range relations {
+		followings[r.ToUserID] = true
+		followingIDs = append(followingIDs, int64(r.ToUserID))
+	}
+
+	// 2. 当前用户的点赞状态
+	favoriteRelations, err := db.GetFavoriteVideoRelationsByUserVideoIDs(ctx, viewerID, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	favorites := make(map[uint]bool, len(favoriteRelations))
+	for _, f := range favoriteRelations {
+		favorites[f.VideoID] = true
+	}
+
+	// 3. 关注的人对候选视频的点赞人数
+	followeeFavorites, err := db.CountFavoritesByUserIDsVideoIDs(ctx, followingIDs, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range candidates {
+		c.FollowingAuthor = followings[c.Video.AuthorID]
+		c.Favorited = favorites[c.Video.ID]
+		c.FolloweeFavorites = followeeFavorites[c.Video.ID]
+	}
+	return candidates, nil
+}
@@ -0,0 +1,67 @@
+package recommend
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// Weights 加权排序器的各项权重
+type Weights struct {
+	Favorite  float64       `mapstructure:"favorite"`  // 点赞数权重，按 log(1+n) 计入
+	Comment   float64       `mapstructure:"comment"`   // 评论数权重，按 log(1+n) 计入
+	Following float64       `mapstructure:"following"` // 关注作者的加分
+	Social    float64       `mapstructure:"social"`    // 关注的人点赞数权重，按 log(1+n) 计入
+	Seen      float64       `mapstructure:"seen"`      // 已点赞视频的得分系数，小于 1 时降低其排序
+	HalfLife  time.Duration `mapstructure:"halfLife"`  // 时间衰减半衰期，发布时长每增加一个半衰期得分减半
+}
+
+// WeightedRanker 加权排序器，以热度、社交关系信号的加权和乘以时间衰减作为得分
+type WeightedRanker struct {
+	weights Weights
+	now     func() time.Time
+}
+
+// NewWeightedRanker 创建加权排序器
+func NewWeightedRanker(weights Weights) *WeightedRanker {
+	return &WeightedRanker{weights: weights, now: time.Now}
+}
+
+// score 计算候选视频得分
+func (r *WeightedRanker) score(c *Candidate, now time.Time) float64 {
+	w := r.weights
+	score := 1 +
+		w.Favorite*math.Log1p(float64(c.Video.FavoriteCount)) +
+		w.Comment*math.Log1p(float64(c.Video.CommentCount)) +
+		w.Social*math.Log1p(float64(c.FolloweeFavorites))
+	if c.FollowingAuthor {
+		score += w.Following
+	}
+	if c.Favorited {
+		score *= w.Seen
+	}
+	if w.HalfLife > 0 {
+		age := now.Sub(c.Video.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		score *= math.Exp2(-float64(age) / float64(w.HalfLife))
+	}
+	return score
+}
+
+// Rank implements the Ranker interface. 得分相同时按发布时间倒序
+func (r *WeightedRanker) Rank(_ context.Context, _ int64, candidates []*Candidate) ([]*Candidate, error) {
+	now := r.now()
+	for _, c := range candidates {
+		c.Score = r.score(c, now)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Video.CreatedAt.After(candidates[j].Video.CreatedAt)
+	})
+	return candidates, nil
+}
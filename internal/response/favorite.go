@@ -8,5 +8,6 @@ type FavoriteAction struct {
 
 type FavoriteList struct {
 	Base
-	VideoList []*video.Video `json:"video_list"`
+	VideoList  []*video.Video `json:"video_list"`
+	NextCursor string         `json:"next_cursor"`
 }
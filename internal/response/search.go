@@ -0,0 +1,18 @@
+package response
+
+import (
+	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/user"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/video"
+)
+
+type SearchUser struct {
+	Base
+	UserList   []*user.User `json:"user_list"`
+	NextOffset int64        `json:"next_offset"`
+}
+
+type SearchVideo struct {
+	Base
+	VideoList  []*video.Video `json:"video_list"`
+	NextOffset int64          `json:"next_offset"`
+}
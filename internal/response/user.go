@@ -6,17 +6,80 @@ import (
 
 type Register struct {
 	Base
-	UserID int64  `json:"user_id"`
-	Token  string `json:"token"`
+	UserID       int64  `json:"user_id"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 type Login struct {
 	Base
-	UserID int64  `json:"user_id"`
-	Token  string `json:"token"`
+	UserID         int64  `json:"user_id"`
+	Token          string `json:"token"`
+	RefreshToken   string `json:"refresh_token"`
+	RetryAfter     int64  `json:"retry_after,omitempty"`     // 登录失败次数过多时需等待的秒数
+	MfaRequired    bool   `json:"mfa_required,omitempty"`    // 已开启两步验证，需使用 challenge_token 提交验证码完成登录
+	ChallengeToken string `json:"challenge_token,omitempty"` // 两步验证的挑战令牌
+}
+
+type RefreshToken struct {
+	Base
+	UserID       int64  `json:"user_id"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type Logout struct {
+	Base
+}
+
+type ListSessions struct {
+	Base
+	Sessions []*user.Session `json:"sessions"`
+}
+
+type RevokeSession struct {
+	Base
+}
+
+type EnrollTOTP struct {
+	Base
+	OtpauthURI    string   `json:"otpauth_uri"`
+	Secret        string   `json:"secret"`
+	RecoveryCodes []string `json:"recovery_codes"` // 一次性恢复码，仅在绑定时返回一次
+}
+
+type ConfirmTOTP struct {
+	Base
+}
+
+type VerifyTOTPLogin struct {
+	Base
+	UserID       int64  `json:"user_id"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type DisableTOTP struct {
+	Base
 }
 
 type UserInfo struct {
 	Base
 	User *user.User `json:"user"`
 }
+
+type UpdateAvatar struct {
+	Base
+	Avatar string `json:"avatar"`
+}
+
+type UpdateBackground struct {
+	Base
+	BackgroundImage string `json:"background_image"`
+}
+
+type UpdateProfile struct {
+	Base
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+}
@@ -6,15 +6,95 @@ import (
 
 type PublishAction struct {
 	Base
+	VideoID int64 `json:"video_id"`
 }
 
 type PublishList struct {
 	Base
-	VideoList []*video.Video `json:"video_list"`
+	VideoList  []*video.Video `json:"video_list"`
+	NextCursor string         `json:"next_cursor"`
 }
 
 type Feed struct {
 	Base
-	NextTime  int64          `json:"next_time"`
-	VideoList []*video.Video `json:"video_list"`
+	NextTime   int64          `json:"next_time"`
+	NextCursor string         `json:"next_cursor"`
+	VideoList  []*video.Video `json:"video_list"`
+}
+
+type UploadInit struct {
+	Base
+	UploadID      string  `json:"upload_id"`
+	PartSize      int64   `json:"part_size"`
+	PartCount     int32   `json:"part_count"`
+	UploadedParts []int32 `json:"uploaded_parts"`
+}
+
+type UploadPart struct {
+	Base
+	PartNumber int32 `json:"part_number"`
+}
+
+type UploadComplete struct {
+	Base
+	VideoID int64 `json:"video_id"`
+}
+
+type UploadAbort struct {
+	Base
+}
+
+type PublishStatus struct {
+	Base
+	VideoID       int64  `json:"video_id"`
+	ProcessStatus int32  `json:"process_status"`
+	Retries       int32  `json:"retries"`
+	FailReason    string `json:"fail_reason,omitempty"`
+}
+
+type DraftList struct {
+	Base
+	VideoList  []*video.Video `json:"video_list"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+type PublishDraft struct {
+	Base
+}
+
+type DeleteVideo struct {
+	Base
+}
+
+type UpdateVideo struct {
+	Base
+}
+
+type PlayAction struct {
+	Base
+}
+
+type TopicFeed struct {
+	Base
+	Tag        *video.Tag     `json:"tag"`
+	VideoList  []*video.Video `json:"video_list"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+type TrendingTags struct {
+	Base
+	Tags []*video.Tag `json:"tags"`
+}
+
+type ShareVideo struct {
+	Base
+	ShareCode  string `json:"share_code"`
+	ShareUrl   string `json:"share_url"`
+	ShareCount int64  `json:"share_count"`
+}
+
+type ResolveShare struct {
+	Base
+	Video    *video.Video `json:"video"`
+	SharerId int64        `json:"sharer_id"`
 }
@@ -0,0 +1,122 @@
+package tool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+)
+
+const (
+	coverJPEGQuality = 85
+	// 评分时将帧缩放至该宽度，降低计算量
+	coverScoreWidth = 64
+	// 平均亮度低于或高于该值的帧视为黑屏或白屏
+	coverMinBrightness = 24
+	coverMaxBrightness = 232
+	// 灰度直方图熵低于该值的帧视为画面单调，如纯色过渡帧
+	coverMinEntropy = 4.0
+)
+
+// GetFrameImage 截取视频在指定时间点的画面
+func GetFrameImage(videoPath string, seconds float64) (image.Image, error) {
+	buf := bytes.NewBuffer(nil)
+	err := ffmpeg.Input(videoPath, ffmpeg.KwArgs{"ss": fmt.Sprintf("%.3f", seconds)}).
+		Output("pipe:", ffmpeg.KwArgs{"vframes": 1, "format": "image2", "vcodec": "mjpeg"}).
+		WithOutput(buf).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+	return imaging.Decode(buf)
+}
+
+// scoreFrame 计算画面的灰度直方图熵作为评分，黑屏、白屏及画面单调的帧返回 false
+func scoreFrame(img image.Image) (float64, bool) {
+	gray := imaging.Grayscale(imaging.Resize(img, coverScoreWidth, 0, imaging.Box))
+	var hist [256]int
+	var sum int
+	for i := 0; i < len(gray.Pix); i += 4 {
+		hist[gray.Pix[i]]++
+		sum += int(gray.Pix[i])
+	}
+	total := len(gray.Pix) / 4
+	if total == 0 {
+		return 0, false
+	}
+	brightness := sum / total
+	var entropy float64
+	for _, n := range hist {
+		if n == 0 {
+			continue
+		}
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	if brightness < coverMinBrightness || brightness > coverMaxBrightness || entropy < coverMinEntropy {
+		return entropy, false
+	}
+	return entropy, true
+}
+
+// SelectCoverFrame 在视频中均匀采样若干帧，跳过片头片尾，选出信息量最高的一帧作为封面。
+// 若所有帧都不合格，则退而选择评分最高的一帧
+func SelectCoverFrame(videoPath string, duration float64, samples int) (image.Image, error) {
+	if samples <= 0 {
+		samples = 1
+	}
+	var (
+		best, fallback           image.Image
+		bestScore, fallbackScore = -1.0, -1.0
+		lastErr                  error
+	)
+	for i := 0; i < samples; i++ {
+		// 在时长的 10% ~ 90% 之间均匀采样
+		ts := duration * (0.1 + 0.8*(float64(i)+0.5)/float64(samples))
+		img, err := GetFrameImage(videoPath, ts)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		score, ok := scoreFrame(img)
+		if ok && score > bestScore {
+			best, bestScore = img, score
+		}
+		if score > fallbackScore {
+			fallback, fallbackScore = img, score
+		}
+	}
+	if best != nil {
+		return best, nil
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no frame captured")
+	}
+	return nil, lastErr
+}
+
+// ThumbnailName 根据封面对象名生成指定宽度缩略图的对象名
+func ThumbnailName(coverTitle string, width int) string {
+	return fmt.Sprintf("%s_%d.jpg", strings.TrimSuffix(coverTitle, path.Ext(coverTitle)), width)
+}
+
+// EncodeJPEG 将图像编码为 JPEG，width 大于 0 时按宽度等比缩放
+func EncodeJPEG(img image.Image, width int) (*bytes.Buffer, error) {
+	if width > 0 && img.Bounds().Dx() > width {
+		img = imaging.Resize(img, width, 0, imaging.Lanczos)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := imaging.Encode(buf, img, imaging.JPEG, imaging.JPEGQuality(coverJPEGQuality)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
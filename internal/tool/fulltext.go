@@ -0,0 +1,59 @@
+package tool
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxFulltextTerms 搜索关键词最多保留的词数
+const MaxFulltextTerms = 8
+
+// isCJK 判断字符是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// splitTerms 将关键词按非字母数字字符切分，并将中日韩文字与其他字符拆分为不同的词
+func splitTerms(keyword string) []string {
+	terms := make([]string, 0)
+	var b strings.Builder
+	lastCJK := false
+	flush := func() {
+		if b.Len() > 0 {
+			terms = append(terms, b.String())
+			b.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(keyword) {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '_' {
+			flush()
+			continue
+		}
+		if cjk := isCJK(r); cjk != lastCJK {
+			flush()
+			lastCJK = cjk
+		}
+		b.WriteRune(r)
+	}
+	flush()
+	return terms
+}
+
+// FulltextQuery 将搜索关键词转换为 MySQL 布尔模式的全文检索表达式，配合 ngram 分词器使用。
+// 每个词均为必须匹配：中日韩文字按短语匹配，其他词及单个字符按前缀匹配。关键词中没有可搜索的词时返回空字符串
+func FulltextQuery(keyword string) string {
+	terms := splitTerms(keyword)
+	if len(terms) > MaxFulltextTerms {
+		terms = terms[:MaxFulltextTerms]
+	}
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		first := []rune(t)
+		if len(first) > 1 && isCJK(first[0]) {
+			parts = append(parts, `+"`+t+`"`)
+		} else {
+			parts = append(parts, "+"+t+"*")
+		}
+	}
+	return strings.Join(parts, " ")
+}
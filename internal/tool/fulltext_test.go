@@ -0,0 +1,22 @@
+package tool
+
+import "testing"
+
+func TestFulltextQuery(t *testing.T) {
+	cases := []struct {
+		keyword string
+		want    string
+	}{
+		{"Hello", "+hello*"},
+		{"go语言 教程", `+go* +"语言" +"教程"`},
+		{"猫", "+猫*"},
+		{`+foo -"bar" (baz)~`, "+foo* +bar* +baz*"},
+		{"  !!  ", ""},
+		{"a b c d e f g h i j", "+a* +b* +c* +d* +e* +f* +g* +h*"},
+	}
+	for _, c := range cases {
+		if got := FulltextQuery(c.keyword); got != c.want {
+			t.Errorf("FulltextQuery(%q) = %q, want %q", c.keyword, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package tool
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxHashtags 单个标题最多提取的话题数
+	MaxHashtags = 5
+	// MaxHashtagLength 话题名最大长度，单位字符，超出的话题被忽略
+	MaxHashtagLength = 20
+)
+
+// 话题以 # 开头，由字母（含中日韩文字）、数字及下划线组成
+var hashtagPattern = regexp.MustCompile(`#([\p{L}\p{N}_]+)`)
+
+// ExtractHashtags 从标题中提取话题，统一转为小写并去重，按出现顺序返回
+func ExtractHashtags(title string) []string {
+	tags := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, m := range hashtagPattern.FindAllStringSubmatch(title, -1) {
+		tag := strings.ToLower(m[1])
+		if utf8.RuneCountInString(tag) > MaxHashtagLength {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+		if len(tags) == MaxHashtags {
+			break
+		}
+	}
+	return tags
+}
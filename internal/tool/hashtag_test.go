@@ -0,0 +1,24 @@
+package tool
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	cases := []struct {
+		title string
+		want  []string
+	}{
+		{"今天去爬山 #旅行 #Travel_2023", []string{"旅行", "travel_2023"}},
+		{"#a#b #A", []string{"a", "b"}},
+		{"没有话题 # 也不算", []string{}},
+		{"#一二三四五六七八九十一二三四五六七八九十一 #ok", []string{"ok"}},
+		{"#1 #2 #3 #4 #5 #6", []string{"1", "2", "3", "4", "5"}},
+	}
+	for _, c := range cases {
+		if got := ExtractHashtags(c.title); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ExtractHashtags(%q) = %v, want %v", c.title, got, c.want)
+		}
+	}
+}
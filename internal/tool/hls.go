@@ -0,0 +1,77 @@
+package tool
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+)
+
+// HLSMasterPlaylist HLS 主播放列表文件名
+const HLSMasterPlaylist = "master.m3u8"
+
+// HLSRendition HLS 转码档位
+type HLSRendition struct {
+	Name         string `mapstructure:"name"`         // 档位名称，同时作为子目录名，如 720p
+	Height       int    `mapstructure:"height"`       // 输出高度，宽度按原视频比例缩放
+	VideoBitrate int    `mapstructure:"videoBitrate"` // 视频码率，单位kbps
+	AudioBitrate int    `mapstructure:"audioBitrate"` // 音频码率，单位kbps
+}
+
+// TranscodeHLS 将视频转码为多码率 HLS，输出目录中包含主播放列表及各档位的子目录。
+// 高于原视频分辨率的档位会被跳过，但至少保留最低的一档
+func TranscodeHLS(videoPath, outputDir string, renditions []HLSRendition, segmentDuration int) error {
+	if len(renditions) == 0 {
+		return errors.New("no hls rendition configured")
+	}
+	meta, err := ProbeVideo(videoPath)
+	if err != nil {
+		return err
+	}
+	width, height := meta.Width, meta.Height
+
+	var master strings.Builder
+	master.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	lowest := 0
+	for i, r := range renditions {
+		if r.Height < renditions[lowest].Height {
+			lowest = i
+		}
+	}
+	for i, r := range renditions {
+		if r.Height > height && i != lowest {
+			continue
+		}
+		dir := filepath.Join(outputDir, r.Name)
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		err = ffmpeg.Input(videoPath).
+			Output(filepath.Join(dir, "index.m3u8"), ffmpeg.KwArgs{
+				"vf":                   fmt.Sprintf("scale=-2:%d", r.Height),
+				"c:v":                  "libx264",
+				"b:v":                  fmt.Sprintf("%dk", r.VideoBitrate),
+				"maxrate":              fmt.Sprintf("%dk", r.VideoBitrate*107/100),
+				"bufsize":              fmt.Sprintf("%dk", r.VideoBitrate*3/2),
+				"c:a":                  "aac",
+				"b:a":                  fmt.Sprintf("%dk", r.AudioBitrate),
+				"f":                    "hls",
+				"hls_time":             segmentDuration,
+				"hls_playlist_type":    "vod",
+				"hls_segment_filename": filepath.Join(dir, "seg_%03d.ts"),
+			}).
+			OverWriteOutput().
+			Run()
+		if err != nil {
+			return fmt.Errorf("transcode %s: %w", r.Name, err)
+		}
+		// 与 scale=-2 保持一致，宽度取偶数
+		w := width * r.Height / height / 2 * 2
+		master.WriteString(fmt.Sprintf("#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d\n%s/index.m3u8\n",
+			(r.VideoBitrate+r.AudioBitrate)*1000, w, r.Height, r.Name))
+	}
+	return os.WriteFile(filepath.Join(outputDir, HLSMasterPlaylist), []byte(master.String()), 0o644)
+}
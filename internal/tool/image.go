@@ -0,0 +1,40 @@
+package tool
+
+import (
+	"bytes"
+	"errors"
+	"image"
+
+	"github.com/disintegration/imaging"
+)
+
+var (
+	ErrInvalidImage  = errors.New("invalid image")
+	ErrImageTooLarge = errors.New("image resolution too large")
+)
+
+// DecodeImage 解码图片并按 EXIF 信息校正方向，解码前先读取尺寸，拒绝像素数超过 maxPixels 的图片，避免解码时耗尽内存
+func DecodeImage(data []byte, maxPixels int) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if maxPixels > 0 && cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	return img, nil
+}
+
+// FillJPEG 将图片等比缩放并居中裁剪至指定尺寸，编码为 JPEG
+func FillJPEG(img image.Image, width, height int) (*bytes.Buffer, error) {
+	img = imaging.Fill(img, width, height, imaging.Center, imaging.Lanczos)
+	buf := bytes.NewBuffer(nil)
+	if err := imaging.Encode(buf, img, imaging.JPEG, imaging.JPEGQuality(coverJPEGQuality)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
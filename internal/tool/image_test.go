@@ -0,0 +1,65 @@
+package tool
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/disintegration/imaging"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeImage(t *testing.T) {
+	data := encodePNG(t, 300, 200)
+	img, err := DecodeImage(data, 300*200)
+	if err != nil {
+		t.Fatalf("DecodeImage err = %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 300 || b.Dy() != 200 {
+		t.Errorf("DecodeImage bounds = %v", b)
+	}
+	if _, err := DecodeImage(data, 300*200-1); err != ErrImageTooLarge {
+		t.Errorf("DecodeImage err = %v, want ErrImageTooLarge", err)
+	}
+	if _, err := DecodeImage([]byte("not an image"), 0); err != ErrInvalidImage {
+		t.Errorf("DecodeImage err = %v, want ErrInvalidImage", err)
+	}
+	if _, err := DecodeImage(data[:len(data)/2], 0); err != ErrInvalidImage {
+		t.Errorf("DecodeImage truncated err = %v, want ErrInvalidImage", err)
+	}
+}
+
+func TestFillJPEG(t *testing.T) {
+	img, err := DecodeImage(encodePNG(t, 300, 200), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, size := range [][2]int{{100, 100}, {400, 200}} {
+		buf, err := FillJPEG(img, size[0], size[1])
+		if err != nil {
+			t.Fatalf("FillJPEG err = %v", err)
+		}
+		out, err := imaging.Decode(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := out.Bounds(); b.Dx() != size[0] || b.Dy() != size[1] {
+			t.Errorf("FillJPEG(%v) bounds = %v", size, b)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package tool
+
+import "time"
+
+// LockoutPolicy 连续失败的退避与锁定策略：前 FreeAttempts 次失败不限制，之后每次失败需等待的时间按指数增长，
+// 从 BackoffBase 开始翻倍且不超过 BackoffMax，失败次数达到 MaxAttempts 时锁定 LockDuration
+type LockoutPolicy struct {
+	FreeAttempts int
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	LockDuration time.Duration
+}
+
+// Delay 返回第 failures 次失败后需等待的时间，locked 表示已达到锁定阈值
+func (p LockoutPolicy) Delay(failures int) (delay time.Duration, locked bool) {
+	if p.MaxAttempts > 0 && failures >= p.MaxAttempts {
+		return p.LockDuration, true
+	}
+	if failures <= p.FreeAttempts || p.BackoffBase <= 0 {
+		return 0, false
+	}
+	delay = p.BackoffBase
+	for i := p.FreeAttempts + 1; i < failures; i++ {
+		delay *= 2
+		if p.BackoffMax > 0 && delay >= p.BackoffMax {
+			return p.BackoffMax, false
+		}
+	}
+	if p.BackoffMax > 0 && delay > p.BackoffMax {
+		delay = p.BackoffMax
+	}
+	return delay, false
+}
+
+// Schedule 依次返回第 1 次起每次失败后需等待的时间，直至达到锁定阈值或等待时间不再增长，
+// 之后的失败与最后一项相同，locked 表示最后一项为锁定时长
+func (p LockoutPolicy) Schedule() (delays []time.Duration, locked bool) {
+	for failures := 1; ; failures++ {
+		delay, locked := p.Delay(failures)
+		delays = append(delays, delay)
+		if locked {
+			return delays, true
+		}
+		// 配置了锁定阈值时一直计算到锁定为止
+		if p.MaxAttempts > 0 || failures <= p.FreeAttempts {
+			continue
+		}
+		if next, _ := p.Delay(failures + 1); next <= delay {
+			return delays, false
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package tool
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicyDelay(t *testing.T) {
+	p := LockoutPolicy{
+		FreeAttempts: 3,
+		MaxAttempts:  10,
+		BackoffBase:  time.Second,
+		BackoffMax:   10 * time.Second,
+		LockDuration: 15 * time.Minute,
+	}
+	tests := []struct {
+		failures int
+		delay    time.Duration
+		locked   bool
+	}{
+		{0, 0, false},
+		{3, 0, false},
+		{4, time.Second, false},
+		{5, 2 * time.Second, false},
+		{7, 8 * time.Second, false},
+		{8, 10 * time.Second, false},
+		{9, 10 * time.Second, false},
+		{10, 15 * time.Minute, true},
+		{100, 15 * time.Minute, true},
+	}
+	for _, tt := range tests {
+		delay, locked := p.Delay(tt.failures)
+		if delay != tt.delay || locked != tt.locked {
+			t.Errorf("Delay(%d) = %v, %v, want %v, %v", tt.failures, delay, locked, tt.delay, tt.locked)
+		}
+	}
+
+	// 未配置锁定阈值时只做退避
+	p.MaxAttempts = 0
+	if delay, locked := p.Delay(1000); delay != 10*time.Second || locked {
+		t.Errorf("Delay without MaxAttempts = %v, %v", delay, locked)
+	}
+}
+
+func TestLockoutPolicySchedule(t *testing.T) {
+	p := LockoutPolicy{
+		FreeAttempts: 2,
+		MaxAttempts:  6,
+		BackoffBase:  time.Second,
+		BackoffMax:   3 * time.Second,
+		LockDuration: time.Minute,
+	}
+	delays, locked := p.Schedule()
+	want := []time.Duration{0, 0, time.Second, 2 * time.Second, 3 * time.Second, time.Minute}
+	if !locked || len(delays) != len(want) {
+		t.Fatalf("Schedule() = %v, %v, want %v, true", delays, locked, want)
+	}
+	for i := range want {
+		if delays[i] != want[i] {
+			t.Fatalf("Schedule() = %v, want %v", delays, want)
+		}
+	}
+
+	// 未配置锁定阈值时计算到等待时间上限为止
+	p.MaxAttempts = 0
+	delays, locked = p.Schedule()
+	if locked || len(delays) != 5 || delays[4] != 3*time.Second {
+		t.Errorf("Schedule without MaxAttempts = %v, %v", delays, locked)
+	}
+
+	// 未配置退避时只有免限制次数
+	p.BackoffBase = 0
+	delays, locked = p.Schedule()
+	if locked || len(delays) != 3 || delays[2] != 0 {
+		t.Errorf("Schedule without backoff = %v, %v", delays, locked)
+	}
+}
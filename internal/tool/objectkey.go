@@ -0,0 +1,13 @@
+package tool
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// NewObjectKey 生成对象存储中的对象名（不含扩展名），格式为 {ownerID}/{uuid}，
+// 不包含用户输入的内容，便于 CDN 缓存及按用户清理
+func NewObjectKey(ownerID int64) string {
+	return fmt.Sprintf("%d/%s", ownerID, uuid.NewString())
+}
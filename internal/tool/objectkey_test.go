@@ -0,0 +1,17 @@
+package tool
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNewObjectKey(t *testing.T) {
+	pattern := regexp.MustCompile(`^42/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	a, b := NewObjectKey(42), NewObjectKey(42)
+	if !pattern.MatchString(a) {
+		t.Errorf("NewObjectKey(42) = %q, want {ownerID}/{uuid}", a)
+	}
+	if a == b {
+		t.Errorf("NewObjectKey returned duplicate keys: %q", a)
+	}
+}
@@ -0,0 +1,229 @@
+package tool
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 密码哈希算法
+const (
+	PasswordAlgorithmMD5      = "md5" // 历史遗留的无盐 MD5，仅用于校验旧密码
+	PasswordAlgorithmBcrypt   = "bcrypt"
+	PasswordAlgorithmArgon2id = "argon2id"
+)
+
+var ErrUnknownPasswordHash = errors.New("unknown password hash format")
+
+// PasswordHasher 密码哈希算法，生成的哈希自带算法标识与参数，以便兼容多种算法及参数升级
+type PasswordHasher interface {
+	// Algorithm 返回算法名称
+	Algorithm() string
+	// Hash 计算密码的哈希
+	Hash(password string) (string, error)
+	// Match 判断哈希是否由该算法生成
+	Match(hash string) bool
+	// Verify 校验密码与哈希是否匹配
+	Verify(password, hash string) (bool, error)
+	// NeedsRehash 判断哈希的参数是否与当前配置不一致，需要重新计算
+	NeedsRehash(hash string) bool
+}
+
+// MD5Hasher 无盐 MD5，与 Md5Encrypt 的结果一致
+type MD5Hasher struct{}
+
+func (MD5Hasher) Algorithm() string {
+	return PasswordAlgorithmMD5
+}
+
+func (MD5Hasher) Hash(password string) (string, error) {
+	return Md5Encrypt(password), nil
+}
+
+func (MD5Hasher) Match(hash string) bool {
+	if len(hash) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+func (MD5Hasher) Verify(password, hash string) (bool, error) {
+	return subtle.ConstantTimeCompare([]byte(Md5Encrypt(password)), []byte(strings.ToLower(hash))) == 1, nil
+}
+
+func (MD5Hasher) NeedsRehash(string) bool {
+	return true
+}
+
+// BcryptHasher bcrypt，Cost 为计算强度，取值范围 4~31
+type BcryptHasher struct {
+	Cost int
+}
+
+func (h BcryptHasher) Algorithm() string {
+	return PasswordAlgorithmBcrypt
+}
+
+func (h BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h BcryptHasher) Match(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func (h BcryptHasher) Verify(password, hash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (h BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.Cost
+}
+
+// Argon2idHasher argon2id，哈希格式为 $argon2id$v=19$m={Memory},t={Iterations},p={Parallelism}${salt}${key}
+type Argon2idHasher struct {
+	Memory      uint32 // 内存开销，单位 KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// argon2idParams 从哈希中解析出的参数
+type argon2idParams struct {
+	memory, iterations uint32
+	parallelism        uint8
+	salt, key          []byte
+}
+
+func (h Argon2idHasher) Algorithm() string {
+	return PasswordAlgorithmArgon2id
+}
+
+func (h Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.Iterations, h.Memory, h.Parallelism, h.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.Memory, h.Iterations, h.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h Argon2idHasher) Match(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+// parse 解析哈希中的参数、盐与密钥
+func (h Argon2idHasher) parse(hash string) (*argon2idParams, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != PasswordAlgorithmArgon2id {
+		return nil, ErrUnknownPasswordHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrUnknownPasswordHash
+	}
+	p := new(argon2idParams)
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return nil, ErrUnknownPasswordHash
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrUnknownPasswordHash
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return nil, ErrUnknownPasswordHash
+	}
+	return p, nil
+}
+
+func (h Argon2idHasher) Verify(password, hash string) (bool, error) {
+	p, err := h.parse(hash)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), p.salt, p.iterations, p.memory, p.parallelism, uint32(len(p.key)))
+	return subtle.ConstantTimeCompare(key, p.key) == 1, nil
+}
+
+func (h Argon2idHasher) NeedsRehash(hash string) bool {
+	p, err := h.parse(hash)
+	return err != nil || p.memory != h.Memory || p.iterations != h.Iterations || p.parallelism != h.Parallelism ||
+		uint32(len(p.salt)) != h.SaltLength || uint32(len(p.key)) != h.KeyLength
+}
+
+// PasswordManager 使用当前算法计算哈希，并兼容校验其他算法生成的哈希
+type PasswordManager struct {
+	current PasswordHasher
+	hashers []PasswordHasher
+}
+
+// NewPasswordManager 创建密码管理器，legacy 为仍需支持校验的旧算法
+func NewPasswordManager(current PasswordHasher, legacy ...PasswordHasher) *PasswordManager {
+	return &PasswordManager{
+		current: current,
+		hashers: append([]PasswordHasher{current}, legacy...),
+	}
+}
+
+// Hash 使用当前算法计算密码的哈希
+func (m *PasswordManager) Hash(password string) (string, error) {
+	return m.current.Hash(password)
+}
+
+// Verify 校验密码，匹配且哈希不是由当前算法及参数生成时 rehash 为 true，调用方应使用 Hash 重新计算并保存
+func (m *PasswordManager) Verify(password, hash string) (ok bool, rehash bool, err error) {
+	for _, h := range m.hashers {
+		if !h.Match(hash) {
+			continue
+		}
+		if ok, err = h.Verify(password, hash); err != nil || !ok {
+			return false, false, err
+		}
+		rehash = h.Algorithm() != m.current.Algorithm() || m.current.NeedsRehash(hash)
+		return true, rehash, nil
+	}
+	return false, false, ErrUnknownPasswordHash
+}
+
+// NewPasswordHasher 根据算法名称创建哈希算法
+func NewPasswordHasher(algorithm string, bcryptCost int, argon2id Argon2idHasher) (PasswordHasher, error) {
+	switch algorithm {
+	case PasswordAlgorithmBcrypt:
+		if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("invalid bcrypt cost: %d", bcryptCost)
+		}
+		return BcryptHasher{Cost: bcryptCost}, nil
+	case PasswordAlgorithmArgon2id:
+		if argon2id.Memory == 0 || argon2id.Iterations == 0 || argon2id.Parallelism == 0 {
+			return nil, errors.New("invalid argon2id parameters")
+		}
+		if argon2id.SaltLength == 0 {
+			argon2id.SaltLength = 16
+		}
+		if argon2id.KeyLength == 0 {
+			argon2id.KeyLength = 32
+		}
+		return argon2id, nil
+	default:
+		return nil, fmt.Errorf("unsupported password algorithm: %s", algorithm)
+	}
+}
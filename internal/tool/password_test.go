@@ -0,0 +1,95 @@
+package tool
+
+import "testing"
+
+func testPasswordHashers(t *testing.T) []PasswordHasher {
+	bc, err := NewPasswordHasher(PasswordAlgorithmBcrypt, 4, Argon2idHasher{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, err := NewPasswordHasher(PasswordAlgorithmArgon2id, 0, Argon2idHasher{Memory: 1024, Iterations: 1, Parallelism: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []PasswordHasher{bc, a2}
+}
+
+func TestPasswordHasher(t *testing.T) {
+	for _, h := range testPasswordHashers(t) {
+		hash, err := h.Hash("password")
+		if err != nil {
+			t.Fatalf("%s Hash err = %v", h.Algorithm(), err)
+		}
+		if !h.Match(hash) || h.NeedsRehash(hash) {
+			t.Errorf("%s hash %q not matched or needs rehash", h.Algorithm(), hash)
+		}
+		if ok, err := h.Verify("password", hash); !ok || err != nil {
+			t.Errorf("%s Verify = %v, %v", h.Algorithm(), ok, err)
+		}
+		if ok, err := h.Verify("Password", hash); ok || err != nil {
+			t.Errorf("%s Verify wrong password = %v, %v", h.Algorithm(), ok, err)
+		}
+		if again, _ := h.Hash("password"); again == hash {
+			t.Errorf("%s hashes are not salted", h.Algorithm())
+		}
+	}
+
+	if (BcryptHasher{Cost: 5}).NeedsRehash(mustHash(t, BcryptHasher{Cost: 4}, "password")) == false {
+		t.Error("bcrypt cost change not detected")
+	}
+	a2 := Argon2idHasher{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	stronger := a2
+	stronger.Iterations = 2
+	if !stronger.NeedsRehash(mustHash(t, a2, "password")) {
+		t.Error("argon2id parameter change not detected")
+	}
+	if _, err := a2.Verify("password", "$argon2id$v=19$broken"); err != ErrUnknownPasswordHash {
+		t.Errorf("argon2id Verify malformed err = %v", err)
+	}
+
+	if _, err := NewPasswordHasher("sha1", 0, Argon2idHasher{}); err == nil {
+		t.Error("unsupported algorithm accepted")
+	}
+	if _, err := NewPasswordHasher(PasswordAlgorithmBcrypt, 100, Argon2idHasher{}); err == nil {
+		t.Error("invalid bcrypt cost accepted")
+	}
+}
+
+func mustHash(t *testing.T, h PasswordHasher, password string) string {
+	hash, err := h.Hash(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
+
+func TestPasswordManager(t *testing.T) {
+	hashers := testPasswordHashers(t)
+	bc, a2 := hashers[0], hashers[1]
+	m := NewPasswordManager(a2, bc, MD5Hasher{})
+
+	cases := []struct {
+		hash   string
+		rehash bool
+	}{
+		{Md5Encrypt("password"), true},
+		{mustHash(t, bc, "password"), true},
+		{mustHash(t, a2, "password"), false},
+	}
+	for _, c := range cases {
+		ok, rehash, err := m.Verify("password", c.hash)
+		if !ok || rehash != c.rehash || err != nil {
+			t.Errorf("Verify(%q) = %v, %v, %v, want true, %v", c.hash, ok, rehash, err, c.rehash)
+		}
+		if ok, rehash, err := m.Verify("wrong", c.hash); ok || rehash || err != nil {
+			t.Errorf("Verify wrong password (%q) = %v, %v, %v", c.hash, ok, rehash, err)
+		}
+	}
+
+	if _, _, err := m.Verify("password", "plaintext"); err != ErrUnknownPasswordHash {
+		t.Errorf("Verify unknown hash err = %v", err)
+	}
+	if _, _, err := NewPasswordManager(a2).Verify("password", Md5Encrypt("password")); err != ErrUnknownPasswordHash {
+		t.Errorf("Verify without legacy hasher err = %v", err)
+	}
+}
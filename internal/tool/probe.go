@@ -0,0 +1,87 @@
+package tool
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+)
+
+// VideoMeta 视频元数据
+type VideoMeta struct {
+	Formats    []string // 容器格式，ffprobe 可能返回多个别名，如 mov,mp4,m4a
+	VideoCodec string   // 视频编码
+	AudioCodec string   // 音频编码，无音轨时为空
+	Duration   float64  // 时长，单位秒
+	Width      int      // 旋转后的显示宽度
+	Height     int      // 旋转后的显示高度
+	Rotation   int      // 旋转角度
+	Size       int64    // 文件大小，单位字节
+}
+
+type probeResult struct {
+	Streams []struct {
+		CodecType string            `json:"codec_type"`
+		CodecName string            `json:"codec_name"`
+		Width     int               `json:"width"`
+		Height    int               `json:"height"`
+		Tags      map[string]string `json:"tags"`
+		SideData  []struct {
+			Rotation int `json:"rotation"`
+		} `json:"side_data_list"`
+	} `json:"streams"`
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+		Size       string `json:"size"`
+	} `json:"format"`
+}
+
+// ProbeVideo 使用 ffprobe 获取视频元数据，文件中没有视频流时返回错误
+func ProbeVideo(videoPath string) (*VideoMeta, error) {
+	data, err := ffmpeg.Probe(videoPath)
+	if err != nil {
+		return nil, err
+	}
+	probe := new(probeResult)
+	if err = json.Unmarshal([]byte(data), probe); err != nil {
+		return nil, err
+	}
+
+	meta := &VideoMeta{
+		Formats: strings.Split(probe.Format.FormatName, ","),
+	}
+	meta.Duration, _ = strconv.ParseFloat(probe.Format.Duration, 64)
+	meta.Size, _ = strconv.ParseInt(probe.Format.Size, 10, 64)
+	for _, s := range probe.Streams {
+		switch s.CodecType {
+		case "video":
+			if meta.VideoCodec != "" {
+				continue
+			}
+			meta.VideoCodec, meta.Width, meta.Height = s.CodecName, s.Width, s.Height
+			// 旧版本 ffprobe 将旋转角度写在 tags 中，新版本写在 side_data_list 中
+			if r, ok := s.Tags["rotate"]; ok {
+				meta.Rotation, _ = strconv.Atoi(r)
+			}
+			for _, sd := range s.SideData {
+				if sd.Rotation != 0 {
+					meta.Rotation = sd.Rotation
+				}
+			}
+		case "audio":
+			if meta.AudioCodec == "" {
+				meta.AudioCodec = s.CodecName
+			}
+		}
+	}
+	if meta.VideoCodec == "" || meta.Width <= 0 || meta.Height <= 0 {
+		return nil, errors.New("video stream not found")
+	}
+	if meta.Rotation%180 != 0 {
+		meta.Width, meta.Height = meta.Height, meta.Width
+	}
+	return meta, nil
+}
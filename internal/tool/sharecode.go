@@ -0,0 +1,51 @@
+package tool
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+)
+
+// ShareSignatureSize 分享码中签名截断后的字节数
+const ShareSignatureSize = 6
+
+// ErrInvalidShareCode 分享码格式错误或签名校验失败
+var ErrInvalidShareCode = errors.New("invalid share code")
+
+// shareSignature 计算分享码载荷的截断 HMAC-SHA256 签名
+func shareSignature(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)[:ShareSignatureSize]
+}
+
+// EncodeShareCode 生成分享码：视频id与分享者id的变长编码后接截断签名，整体以 base64url 编码，
+// 同一用户分享同一视频得到的分享码相同
+func EncodeShareCode(secret []byte, videoID int64, sharerID int64) string {
+	payload := binary.AppendUvarint(nil, uint64(videoID))
+	payload = binary.AppendUvarint(payload, uint64(sharerID))
+	return base64.RawURLEncoding.EncodeToString(append(payload, shareSignature(secret, payload)...))
+}
+
+// DecodeShareCode 校验分享码签名并解析出视频id与分享者id
+func DecodeShareCode(secret []byte, code string) (videoID int64, sharerID int64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil || len(raw) <= ShareSignatureSize {
+		return 0, 0, ErrInvalidShareCode
+	}
+	payload, sig := raw[:len(raw)-ShareSignatureSize], raw[len(raw)-ShareSignatureSize:]
+	if !hmac.Equal(sig, shareSignature(secret, payload)) {
+		return 0, 0, ErrInvalidShareCode
+	}
+	v, n := binary.Uvarint(payload)
+	if n <= 0 {
+		return 0, 0, ErrInvalidShareCode
+	}
+	s, m := binary.Uvarint(payload[n:])
+	if m <= 0 || n+m != len(payload) || v == 0 || v > 1<<63-1 || s > 1<<63-1 {
+		return 0, 0, ErrInvalidShareCode
+	}
+	return int64(v), int64(s), nil
+}
@@ -0,0 +1,26 @@
+package tool
+
+import "testing"
+
+func TestShareCode(t *testing.T) {
+	secret := []byte("secret")
+	code := EncodeShareCode(secret, 42, 7)
+	videoID, sharerID, err := DecodeShareCode(secret, code)
+	if err != nil || videoID != 42 || sharerID != 7 {
+		t.Fatalf("DecodeShareCode(%q) = %d, %d, %v", code, videoID, sharerID, err)
+	}
+	if again := EncodeShareCode(secret, 42, 7); again != code {
+		t.Errorf("EncodeShareCode not stable: %q != %q", again, code)
+	}
+
+	tampered := []byte(code)
+	tampered[0] ^= 1
+	for _, c := range []string{"", "!!!", "AAAA", string(tampered)} {
+		if _, _, err := DecodeShareCode(secret, c); err != ErrInvalidShareCode {
+			t.Errorf("DecodeShareCode(%q) err = %v, want ErrInvalidShareCode", c, err)
+		}
+	}
+	if _, _, err := DecodeShareCode([]byte("other"), code); err != ErrInvalidShareCode {
+		t.Errorf("DecodeShareCode with wrong secret err = %v, want ErrInvalidShareCode", err)
+	}
+}
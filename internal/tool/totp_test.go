@@ -0,0 +1,96 @@
+package tool
+
+import (
+	"bytes"
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量，取后6位
+func TestTOTPCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		code, err := TOTPCode(secret, time.Unix(tt.unix, 0))
+		if err != nil || code != tt.code {
+			t.Errorf("TOTPCode(%d) = %s, %v, want %s", tt.unix, code, err, tt.code)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	code, _ := TOTPCode(secret, now.Add(-TOTPPeriod*time.Second))
+
+	// 允许一个时间步的误差，返回匹配的时间步
+	step, ok, err := VerifyTOTP(strings.ToLower(secret), code, now, 1)
+	if err != nil || !ok || step != TOTPStep(now)-1 {
+		t.Fatalf("VerifyTOTP = %d, %v, %v", step, ok, err)
+	}
+	if _, ok, _ := VerifyTOTP(secret, code, now, 0); ok {
+		t.Fatal("VerifyTOTP should reject code outside skew")
+	}
+	if _, ok, _ := VerifyTOTP(secret, "12345", now, 1); ok {
+		t.Fatal("VerifyTOTP should reject short code")
+	}
+	if _, _, err := VerifyTOTP("not base32!", code, now, 1); err != ErrInvalidTOTPSecret {
+		t.Fatalf("VerifyTOTP err = %v, want ErrInvalidTOTPSecret", err)
+	}
+
+	uri := TOTPURI("Tiktok", "alice", secret)
+	if !strings.HasPrefix(uri, "otpauth://totp/Tiktok:alice?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("TOTPURI = %s", uri)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil || len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes = %v, %v", codes, err)
+	}
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		if len(c) != 11 || c[5] != '-' || seen[c] {
+			t.Fatalf("invalid or duplicate recovery code %q", c)
+		}
+		seen[c] = true
+	}
+	key := []byte("recovery-key")
+	if HashRecoveryCode(key, codes[0]) != HashRecoveryCode(key, " "+strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))) {
+		t.Error("HashRecoveryCode should ignore case, spaces and hyphens")
+	}
+	if HashRecoveryCode(key, codes[0]) == HashRecoveryCode([]byte("other"), codes[0]) {
+		t.Error("HashRecoveryCode should depend on key")
+	}
+}
+
+func TestAESGCM(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	ciphertext, err := AESGCMEncrypt(key, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := AESGCMDecrypt(key, ciphertext)
+	if err != nil || string(plaintext) != "secret" {
+		t.Fatalf("AESGCMDecrypt = %s, %v", plaintext, err)
+	}
+	if _, err := AESGCMDecrypt(bytes.Repeat([]byte{8}, 32), ciphertext); err != ErrInvalidCiphertext {
+		t.Fatalf("AESGCMDecrypt with wrong key err = %v", err)
+	}
+}
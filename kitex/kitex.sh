@@ -9,3 +9,5 @@ kitex -module github.com/bytedance-youthcamp-jbzx/tiktok -I ./ -v -service favor
 kitex -module github.com/bytedance-youthcamp-jbzx/tiktok -I ./ -v -service messagesrv message.proto
 
 kitex -module github.com/bytedance-youthcamp-jbzx/tiktok -I ./ -v -service videosrv video.proto
+
+kitex -module github.com/bytedance-youthcamp-jbzx/tiktok -I ./ -v -service searchsrv search.proto
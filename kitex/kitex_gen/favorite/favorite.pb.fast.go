@@ -115,6 +115,11 @@ func (x *FavoriteListRequest) FastRead(buf []byte, _type int8, number int32) (of
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -138,6 +143,11 @@ func (x *FavoriteListRequest) fastReadField2(buf []byte, _type int8) (offset int
 	return offset, err
 }
 
+func (x *FavoriteListRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *FavoriteListResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -155,6 +165,11 @@ func (x *FavoriteListResponse) FastRead(buf []byte, _type int8, number int32) (o
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -188,6 +203,11 @@ func (x *FavoriteListResponse) fastReadField3(buf []byte, _type int8) (offset in
 	return offset, nil
 }
 
+func (x *FavoriteListResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *FavoriteActionRequest) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -262,6 +282,7 @@ func (x *FavoriteListRequest) FastWrite(buf []byte) (offset int) {
 	}
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
 	return offset
 }
 
@@ -281,6 +302,14 @@ func (x *FavoriteListRequest) fastWriteField2(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *FavoriteListRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Cursor)
+	return offset
+}
+
 func (x *FavoriteListResponse) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -288,6 +317,7 @@ func (x *FavoriteListResponse) FastWrite(buf []byte) (offset int) {
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
 	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
 	return offset
 }
 
@@ -317,6 +347,14 @@ func (x *FavoriteListResponse) fastWriteField3(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *FavoriteListResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.NextCursor)
+	return offset
+}
+
 func (x *FavoriteActionRequest) Size() (n int) {
 	if x == nil {
 		return n
@@ -391,6 +429,7 @@ func (x *FavoriteListRequest) Size() (n int) {
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
 	return n
 }
 
@@ -410,6 +449,14 @@ func (x *FavoriteListRequest) sizeField2() (n int) {
 	return n
 }
 
+func (x *FavoriteListRequest) sizeField3() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.Cursor)
+	return n
+}
+
 func (x *FavoriteListResponse) Size() (n int) {
 	if x == nil {
 		return n
@@ -417,6 +464,7 @@ func (x *FavoriteListResponse) Size() (n int) {
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
@@ -446,6 +494,14 @@ func (x *FavoriteListResponse) sizeField3() (n int) {
 	return n
 }
 
+func (x *FavoriteListResponse) sizeField4() (n int) {
+	if x.NextCursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.NextCursor)
+	return n
+}
+
 var fieldIDToName_FavoriteActionRequest = map[int32]string{
 	1: "UserId",
 	2: "Token",
@@ -461,12 +517,14 @@ var fieldIDToName_FavoriteActionResponse = map[int32]string{
 var fieldIDToName_FavoriteListRequest = map[int32]string{
 	1: "UserId",
 	2: "Token",
+	3: "Cursor",
 }
 
 var fieldIDToName_FavoriteListResponse = map[int32]string{
 	1: "StatusCode",
 	2: "StatusMsg",
 	3: "VideoList",
+	4: "NextCursor",
 }
 
 var _ = video.File_video_proto
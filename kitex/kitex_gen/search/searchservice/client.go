@@ -0,0 +1,55 @@
+// Code generated by Kitex v0.4.4. DO NOT EDIT.
+
+package searchservice
+
+import (
+	"context"
+	search "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/search"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	SearchUser(ctx context.Context, Req *search.SearchUserRequest, callOptions ...callopt.Option) (r *search.SearchUserResponse, err error)
+	SearchVideo(ctx context.Context, Req *search.SearchVideoRequest, callOptions ...callopt.Option) (r *search.SearchVideoResponse, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kSearchServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kSearchServiceClient struct {
+	*kClient
+}
+
+func (p *kSearchServiceClient) SearchUser(ctx context.Context, Req *search.SearchUserRequest, callOptions ...callopt.Option) (r *search.SearchUserResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchUser(ctx, Req)
+}
+
+func (p *kSearchServiceClient) SearchVideo(ctx context.Context, Req *search.SearchVideoRequest, callOptions ...callopt.Option) (r *search.SearchVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchVideo(ctx, Req)
+}
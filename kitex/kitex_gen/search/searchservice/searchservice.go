@@ -0,0 +1,360 @@
+// Code generated by Kitex v0.4.4. DO NOT EDIT.
+
+package searchservice
+
+import (
+	"context"
+	"fmt"
+	search "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/search"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return searchServiceServiceInfo
+}
+
+var searchServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "SearchService"
+	handlerType := (*search.SearchService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"SearchUser":  kitex.NewMethodInfo(searchUserHandler, newSearchUserArgs, newSearchUserResult, false),
+		"SearchVideo": kitex.NewMethodInfo(searchVideoHandler, newSearchVideoArgs, newSearchVideoResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName": "search",
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.4.4",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func searchUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(search.SearchUserRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(search.SearchService).SearchUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SearchUserArgs:
+		success, err := handler.(search.SearchService).SearchUser(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchUserResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSearchUserArgs() interface{} {
+	return &SearchUserArgs{}
+}
+
+func newSearchUserResult() interface{} {
+	return &SearchUserResult{}
+}
+
+type SearchUserArgs struct {
+	Req *search.SearchUserRequest
+}
+
+func (p *SearchUserArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(search.SearchUserRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SearchUserArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SearchUserArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SearchUserArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in SearchUserArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchUserArgs) Unmarshal(in []byte) error {
+	msg := new(search.SearchUserRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchUserArgs_Req_DEFAULT *search.SearchUserRequest
+
+func (p *SearchUserArgs) GetReq() *search.SearchUserRequest {
+	if !p.IsSetReq() {
+		return SearchUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type SearchUserResult struct {
+	Success *search.SearchUserResponse
+}
+
+var SearchUserResult_Success_DEFAULT *search.SearchUserResponse
+
+func (p *SearchUserResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(search.SearchUserResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SearchUserResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SearchUserResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SearchUserResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in SearchUserResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchUserResult) Unmarshal(in []byte) error {
+	msg := new(search.SearchUserResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchUserResult) GetSuccess() *search.SearchUserResponse {
+	if !p.IsSetSuccess() {
+		return SearchUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*search.SearchUserResponse)
+}
+
+func (p *SearchUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func searchVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(search.SearchVideoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(search.SearchService).SearchVideo(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SearchVideoArgs:
+		success, err := handler.(search.SearchService).SearchVideo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchVideoResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSearchVideoArgs() interface{} {
+	return &SearchVideoArgs{}
+}
+
+func newSearchVideoResult() interface{} {
+	return &SearchVideoResult{}
+}
+
+type SearchVideoArgs struct {
+	Req *search.SearchVideoRequest
+}
+
+func (p *SearchVideoArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(search.SearchVideoRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SearchVideoArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SearchVideoArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SearchVideoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in SearchVideoArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchVideoArgs) Unmarshal(in []byte) error {
+	msg := new(search.SearchVideoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchVideoArgs_Req_DEFAULT *search.SearchVideoRequest
+
+func (p *SearchVideoArgs) GetReq() *search.SearchVideoRequest {
+	if !p.IsSetReq() {
+		return SearchVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type SearchVideoResult struct {
+	Success *search.SearchVideoResponse
+}
+
+var SearchVideoResult_Success_DEFAULT *search.SearchVideoResponse
+
+func (p *SearchVideoResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(search.SearchVideoResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SearchVideoResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SearchVideoResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SearchVideoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in SearchVideoResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchVideoResult) Unmarshal(in []byte) error {
+	msg := new(search.SearchVideoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchVideoResult) GetSuccess() *search.SearchVideoResponse {
+	if !p.IsSetSuccess() {
+		return SearchVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*search.SearchVideoResponse)
+}
+
+func (p *SearchVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) SearchUser(ctx context.Context, Req *search.SearchUserRequest) (r *search.SearchUserResponse, err error) {
+	var _args SearchUserArgs
+	_args.Req = Req
+	var _result SearchUserResult
+	if err = p.c.Call(ctx, "SearchUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchVideo(ctx context.Context, Req *search.SearchVideoRequest) (r *search.SearchVideoResponse, err error) {
+	var _args SearchVideoArgs
+	_args.Req = Req
+	var _result SearchVideoResult
+	if err = p.c.Call(ctx, "SearchVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,20 @@
+// Code generated by Kitex v0.4.4. DO NOT EDIT.
+package searchservice
+
+import (
+	search "github.com/bytedance-youthcamp-jbzx/tiktok/kitex/kitex_gen/search"
+	server "github.com/cloudwego/kitex/server"
+)
+
+// NewServer creates a server.Server with the given handler and options.
+func NewServer(handler search.SearchService, opts ...server.Option) server.Server {
+	var options []server.Option
+
+	options = append(options, opts...)
+
+	svr := server.NewServer(options...)
+	if err := svr.RegisterService(serviceInfo(), handler); err != nil {
+		panic(err)
+	}
+	return svr
+}
@@ -24,6 +24,11 @@ func (x *UserRegisterRequest) FastRead(buf []byte, _type int8, number int32) (of
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -47,6 +52,16 @@ func (x *UserRegisterRequest) fastReadField2(buf []byte, _type int8) (offset int
 	return offset, err
 }
 
+func (x *UserRegisterRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v ClientInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Client = &v
+	return offset, nil
+}
+
 func (x *UserRegisterResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -69,6 +84,11 @@ func (x *UserRegisterResponse) FastRead(buf []byte, _type int8, number int32) (o
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -102,7 +122,12 @@ func (x *UserRegisterResponse) fastReadField4(buf []byte, _type int8) (offset in
 	return offset, err
 }
 
-func (x *UserLoginRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *UserRegisterResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ClientInfo) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -114,6 +139,11 @@ func (x *UserLoginRequest) FastRead(buf []byte, _type int8, number int32) (offse
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -124,20 +154,25 @@ func (x *UserLoginRequest) FastRead(buf []byte, _type int8, number int32) (offse
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserLoginRequest[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ClientInfo[number], err)
 }
 
-func (x *UserLoginRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.Username, offset, err = fastpb.ReadString(buf, _type)
+func (x *ClientInfo) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Device, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserLoginRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.Password, offset, err = fastpb.ReadString(buf, _type)
+func (x *ClientInfo) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Ip, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserLoginResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *ClientInfo) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserAgent, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserLoginRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -154,11 +189,6 @@ func (x *UserLoginResponse) FastRead(buf []byte, _type int8, number int32) (offs
 		if err != nil {
 			goto ReadFieldError
 		}
-	case 4:
-		offset, err = x.fastReadField4(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -169,30 +199,30 @@ func (x *UserLoginResponse) FastRead(buf []byte, _type int8, number int32) (offs
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserLoginResponse[number], err)
-}
-
-func (x *UserLoginResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
-	return offset, err
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserLoginRequest[number], err)
 }
 
-func (x *UserLoginResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+func (x *UserLoginRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Username, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserLoginResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *UserLoginRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserLoginResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
-	x.Token, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *UserLoginRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v ClientInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Client = &v
+	return offset, nil
 }
 
-func (x *User) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *UserLoginResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -234,21 +264,6 @@ func (x *User) FastRead(buf []byte, _type int8, number int32) (offset int, err e
 		if err != nil {
 			goto ReadFieldError
 		}
-	case 9:
-		offset, err = x.fastReadField9(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	case 10:
-		offset, err = x.fastReadField10(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	case 11:
-		offset, err = x.fastReadField11(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -259,65 +274,50 @@ func (x *User) FastRead(buf []byte, _type int8, number int32) (offset int, err e
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_User[number], err)
-}
-
-func (x *User) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
-	return offset, err
-}
-
-func (x *User) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.Name, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
-}
-
-func (x *User) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	x.FollowCount, offset, err = fastpb.ReadInt64(buf, _type)
-	return offset, err
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserLoginResponse[number], err)
 }
 
-func (x *User) fastReadField4(buf []byte, _type int8) (offset int, err error) {
-	x.FollowerCount, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *UserLoginResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField5(buf []byte, _type int8) (offset int, err error) {
-	x.IsFollow, offset, err = fastpb.ReadBool(buf, _type)
+func (x *UserLoginResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField6(buf []byte, _type int8) (offset int, err error) {
-	x.Avatar, offset, err = fastpb.ReadString(buf, _type)
+func (x *UserLoginResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField7(buf []byte, _type int8) (offset int, err error) {
-	x.BackgroundImage, offset, err = fastpb.ReadString(buf, _type)
+func (x *UserLoginResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField8(buf []byte, _type int8) (offset int, err error) {
-	x.Signature, offset, err = fastpb.ReadString(buf, _type)
+func (x *UserLoginResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField9(buf []byte, _type int8) (offset int, err error) {
-	x.TotalFavorited, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *UserLoginResponse) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.RetryAfter, offset, err = fastpb.ReadInt64(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField10(buf []byte, _type int8) (offset int, err error) {
-	x.WorkCount, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *UserLoginResponse) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.MfaRequired, offset, err = fastpb.ReadBool(buf, _type)
 	return offset, err
 }
 
-func (x *User) fastReadField11(buf []byte, _type int8) (offset int, err error) {
-	x.FavoriteCount, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *UserLoginResponse) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ChallengeToken, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserInfoRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *RefreshTokenRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -339,20 +339,25 @@ func (x *UserInfoRequest) FastRead(buf []byte, _type int8, number int32) (offset
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserInfoRequest[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenRequest[number], err)
 }
 
-func (x *UserInfoRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *RefreshTokenRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserInfoRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.Token, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *RefreshTokenRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v ClientInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Client = &v
+	return offset, nil
 }
 
-func (x *UserInfoResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *RefreshTokenResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -369,6 +374,16 @@ func (x *UserInfoResponse) FastRead(buf []byte, _type int8, number int32) (offse
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -379,399 +394,3216 @@ func (x *UserInfoResponse) FastRead(buf []byte, _type int8, number int32) (offse
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserInfoResponse[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenResponse[number], err)
 }
 
-func (x *UserInfoResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *RefreshTokenResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
 	return offset, err
 }
 
-func (x *UserInfoResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+func (x *RefreshTokenResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
 	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *UserInfoResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	var v User
-	offset, err = fastpb.ReadMessage(buf, _type, &v)
-	if err != nil {
-		return offset, err
-	}
-	x.User = &v
-	return offset, nil
+func (x *RefreshTokenResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterRequest) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+func (x *RefreshTokenResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.Username)
-	return offset
+func (x *RefreshTokenResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
+func (x *LogoutRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Password)
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutRequest[number], err)
 }
 
-func (x *UserRegisterResponse) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+func (x *LogoutRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.StatusCode == 0 {
-		return offset
-	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+func (x *LogoutRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.StatusMsg == "" {
-		return offset
+func (x *LogoutResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutResponse[number], err)
 }
 
-func (x *UserRegisterResponse) fastWriteField3(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
-	}
-	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
-	return offset
+func (x *LogoutResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
 }
 
-func (x *UserRegisterResponse) fastWriteField4(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
-	return offset
+func (x *LogoutResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollTOTPRequest[number], err)
+}
+
+func (x *EnrollTOTPRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollTOTPResponse[number], err)
+}
+
+func (x *EnrollTOTPResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OtpauthUri, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Secret, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTOTPResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.RecoveryCodes = append(x.RecoveryCodes, v)
+	return offset, err
+}
+
+func (x *ConfirmTOTPRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmTOTPRequest[number], err)
+}
+
+func (x *ConfirmTOTPRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmTOTPRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmTOTPResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmTOTPResponse[number], err)
+}
+
+func (x *ConfirmTOTPResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmTOTPResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyTOTPLoginRequest[number], err)
+}
+
+func (x *VerifyTOTPLoginRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ChallengeToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v ClientInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Client = &v
+	return offset, nil
+}
+
+func (x *VerifyTOTPLoginResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyTOTPLoginResponse[number], err)
+}
+
+func (x *VerifyTOTPLoginResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyTOTPLoginResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DisableTOTPRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableTOTPRequest[number], err)
+}
+
+func (x *DisableTOTPRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DisableTOTPRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DisableTOTPResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableTOTPResponse[number], err)
+}
+
+func (x *DisableTOTPResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *DisableTOTPResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Session[number], err)
+}
+
+func (x *Session) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Device, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Ip, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UserAgent, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.LastSeenAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Current, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListSessionsRequest[number], err)
+}
+
+func (x *ListSessionsRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListSessionsResponse[number], err)
+}
+
+func (x *ListSessionsResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Session
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Sessions = append(x.Sessions, &v)
+	return offset, nil
+}
+
+func (x *RevokeSessionRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeSessionRequest[number], err)
+}
+
+func (x *RevokeSessionRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeSessionRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SessionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeSessionResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeSessionResponse[number], err)
+}
+
+func (x *RevokeSessionResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeSessionResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *User) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_User[number], err)
+}
+
+func (x *User) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.FollowCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.FollowerCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.IsFollow, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Avatar, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.BackgroundImage, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Signature, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.TotalFavorited, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.WorkCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *User) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.FavoriteCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserInfoRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserInfoRequest[number], err)
+}
+
+func (x *UserInfoRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserInfoRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserInfoResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserInfoResponse[number], err)
+}
+
+func (x *UserInfoResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UserInfoResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserInfoResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v User
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.User = &v
+	return offset, nil
+}
+
+func (x *UpdateAvatarRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAvatarRequest[number], err)
+}
+
+func (x *UpdateAvatarRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAvatarRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAvatarResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAvatarResponse[number], err)
+}
+
+func (x *UpdateAvatarResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAvatarResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAvatarResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Avatar, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateBackgroundRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateBackgroundRequest[number], err)
+}
+
+func (x *UpdateBackgroundRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateBackgroundRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateBackgroundResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateBackgroundResponse[number], err)
+}
+
+func (x *UpdateBackgroundResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateBackgroundResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateBackgroundResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.BackgroundImage, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProfileRequest[number], err)
+}
+
+func (x *UpdateProfileRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Signature, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.SetSignature, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProfileResponse[number], err)
+}
+
+func (x *UpdateProfileResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Signature, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserRegisterRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UserRegisterRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Username)
+	return offset
+}
+
+func (x *UserRegisterRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Password)
+	return offset
+}
+
+func (x *UserRegisterRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Client == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.Client)
+	return offset
+}
+
+func (x *UserRegisterResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *UserRegisterResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UserRegisterResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UserRegisterResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
+	return offset
+}
+
+func (x *UserRegisterResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
+	return offset
+}
+
+func (x *UserRegisterResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.RefreshToken)
+	return offset
+}
+
+func (x *ClientInfo) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ClientInfo) fastWriteField1(buf []byte) (offset int) {
+	if x.Device == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Device)
+	return offset
+}
+
+func (x *ClientInfo) fastWriteField2(buf []byte) (offset int) {
+	if x.Ip == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Ip)
+	return offset
+}
+
+func (x *ClientInfo) fastWriteField3(buf []byte) (offset int) {
+	if x.UserAgent == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.UserAgent)
+	return offset
+}
+
+func (x *UserLoginRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UserLoginRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Username)
+	return offset
+}
+
+func (x *UserLoginRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Password)
+	return offset
+}
+
+func (x *UserLoginRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Client == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.Client)
+	return offset
+}
+
+func (x *UserLoginResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.RefreshToken)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField6(buf []byte) (offset int) {
+	if x.RetryAfter == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.RetryAfter)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField7(buf []byte) (offset int) {
+	if !x.MfaRequired {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.MfaRequired)
+	return offset
+}
+
+func (x *UserLoginResponse) fastWriteField8(buf []byte) (offset int) {
+	if x.ChallengeToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.ChallengeToken)
+	return offset
+}
+
+func (x *RefreshTokenRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.RefreshToken)
+	return offset
+}
+
+func (x *RefreshTokenRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Client == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.Client)
+	return offset
+}
+
+func (x *RefreshTokenResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.RefreshToken)
+	return offset
+}
+
+func (x *LogoutRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LogoutRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *LogoutRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.RefreshToken)
+	return offset
+}
+
+func (x *LogoutResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LogoutResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *LogoutResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *EnrollTOTPRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *EnrollTOTPRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *EnrollTOTPResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *EnrollTOTPResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *EnrollTOTPResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *EnrollTOTPResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.OtpauthUri == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.OtpauthUri)
+	return offset
+}
+
+func (x *EnrollTOTPResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Secret == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Secret)
+	return offset
+}
+
+func (x *EnrollTOTPResponse) fastWriteField5(buf []byte) (offset int) {
+	if len(x.RecoveryCodes) == 0 {
+		return offset
+	}
+	for i := range x.RecoveryCodes {
+		offset += fastpb.WriteString(buf[offset:], 5, x.RecoveryCodes[i])
+	}
+	return offset
+}
+
+func (x *ConfirmTOTPRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmTOTPRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *ConfirmTOTPRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Code)
+	return offset
+}
+
+func (x *ConfirmTOTPResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmTOTPResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *ConfirmTOTPResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *VerifyTOTPLoginRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *VerifyTOTPLoginRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.ChallengeToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.ChallengeToken)
+	return offset
+}
+
+func (x *VerifyTOTPLoginRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Code)
+	return offset
+}
+
+func (x *VerifyTOTPLoginRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Client == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.Client)
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
+	return offset
+}
+
+func (x *VerifyTOTPLoginResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.RefreshToken)
+	return offset
+}
+
+func (x *DisableTOTPRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DisableTOTPRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *DisableTOTPRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Code)
+	return offset
+}
+
+func (x *DisableTOTPResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DisableTOTPResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *DisableTOTPResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *Session) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *Session) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Id)
+	return offset
+}
+
+func (x *Session) fastWriteField2(buf []byte) (offset int) {
+	if x.Device == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Device)
+	return offset
+}
+
+func (x *Session) fastWriteField3(buf []byte) (offset int) {
+	if x.Ip == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Ip)
+	return offset
+}
+
+func (x *Session) fastWriteField4(buf []byte) (offset int) {
+	if x.UserAgent == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.UserAgent)
+	return offset
+}
+
+func (x *Session) fastWriteField5(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.CreatedAt)
+	return offset
+}
+
+func (x *Session) fastWriteField6(buf []byte) (offset int) {
+	if x.LastSeenAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.LastSeenAt)
+	return offset
+}
+
+func (x *Session) fastWriteField7(buf []byte) (offset int) {
+	if !x.Current {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.Current)
+	return offset
+}
+
+func (x *ListSessionsRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListSessionsRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *ListSessionsResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListSessionsResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *ListSessionsResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *ListSessionsResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Sessions == nil {
+		return offset
+	}
+	for i := range x.Sessions {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.Sessions[i])
+	}
+	return offset
+}
+
+func (x *RevokeSessionRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RevokeSessionRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *RevokeSessionRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.SessionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.SessionId)
+	return offset
+}
+
+func (x *RevokeSessionResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RevokeSessionResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *RevokeSessionResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *User) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *User) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.Id)
+	return offset
+}
+
+func (x *User) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Name)
+	return offset
+}
+
+func (x *User) fastWriteField3(buf []byte) (offset int) {
+	if x.FollowCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.FollowCount)
+	return offset
+}
+
+func (x *User) fastWriteField4(buf []byte) (offset int) {
+	if x.FollowerCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.FollowerCount)
+	return offset
+}
+
+func (x *User) fastWriteField5(buf []byte) (offset int) {
+	if !x.IsFollow {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.IsFollow)
+	return offset
+}
+
+func (x *User) fastWriteField6(buf []byte) (offset int) {
+	if x.Avatar == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.Avatar)
+	return offset
+}
+
+func (x *User) fastWriteField7(buf []byte) (offset int) {
+	if x.BackgroundImage == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.BackgroundImage)
+	return offset
+}
+
+func (x *User) fastWriteField8(buf []byte) (offset int) {
+	if x.Signature == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.Signature)
+	return offset
+}
+
+func (x *User) fastWriteField9(buf []byte) (offset int) {
+	if x.TotalFavorited == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 9, x.TotalFavorited)
+	return offset
+}
+
+func (x *User) fastWriteField10(buf []byte) (offset int) {
+	if x.WorkCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.WorkCount)
+	return offset
+}
+
+func (x *User) fastWriteField11(buf []byte) (offset int) {
+	if x.FavoriteCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.FavoriteCount)
+	return offset
+}
+
+func (x *UserInfoRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UserInfoRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.UserId)
+	return offset
+}
+
+func (x *UserInfoRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
+	return offset
+}
+
+func (x *UserInfoResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UserInfoResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UserInfoResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UserInfoResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.User == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.User)
+	return offset
+}
+
+func (x *UpdateAvatarRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAvatarRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UpdateAvatarRequest) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 2, x.Data)
+	return offset
+}
+
+func (x *UpdateAvatarResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAvatarResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UpdateAvatarResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UpdateAvatarResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Avatar == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Avatar)
+	return offset
+}
+
+func (x *UpdateBackgroundRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateBackgroundRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UpdateBackgroundRequest) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 2, x.Data)
+	return offset
+}
+
+func (x *UpdateBackgroundResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UpdateBackgroundResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UpdateBackgroundResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UpdateBackgroundResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.BackgroundImage == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.BackgroundImage)
+	return offset
+}
+
+func (x *UpdateProfileRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProfileRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UpdateProfileRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Name)
+	return offset
+}
+
+func (x *UpdateProfileRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Signature == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Signature)
+	return offset
+}
+
+func (x *UpdateProfileRequest) fastWriteField4(buf []byte) (offset int) {
+	if !x.SetSignature {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 4, x.SetSignature)
+	return offset
+}
+
+func (x *UpdateProfileResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProfileResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UpdateProfileResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UpdateProfileResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Name)
+	return offset
+}
+
+func (x *UpdateProfileResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.Signature == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.Signature)
+	return offset
+}
+
+func (x *UserRegisterRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UserRegisterRequest) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Username)
+	return n
+}
+
+func (x *UserRegisterRequest) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Password)
+	return n
+}
+
+func (x *UserRegisterRequest) sizeField3() (n int) {
+	if x.Client == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.Client)
+	return n
+}
+
+func (x *UserRegisterResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *UserRegisterResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UserRegisterResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *UserRegisterResponse) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.UserId)
+	return n
+}
+
+func (x *UserRegisterResponse) sizeField4() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Token)
+	return n
+}
+
+func (x *UserRegisterResponse) sizeField5() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.RefreshToken)
+	return n
+}
+
+func (x *ClientInfo) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ClientInfo) sizeField1() (n int) {
+	if x.Device == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Device)
+	return n
+}
+
+func (x *ClientInfo) sizeField2() (n int) {
+	if x.Ip == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Ip)
+	return n
+}
+
+func (x *ClientInfo) sizeField3() (n int) {
+	if x.UserAgent == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.UserAgent)
+	return n
+}
+
+func (x *UserLoginRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UserLoginRequest) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Username)
+	return n
+}
+
+func (x *UserLoginRequest) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Password)
+	return n
+}
+
+func (x *UserLoginRequest) sizeField3() (n int) {
+	if x.Client == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.Client)
+	return n
+}
+
+func (x *UserLoginResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *UserLoginResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.UserId)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField4() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Token)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField5() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.RefreshToken)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField6() (n int) {
+	if x.RetryAfter == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.RetryAfter)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField7() (n int) {
+	if !x.MfaRequired {
+		return n
+	}
+	n += fastpb.SizeBool(7, x.MfaRequired)
+	return n
+}
+
+func (x *UserLoginResponse) sizeField8() (n int) {
+	if x.ChallengeToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.ChallengeToken)
+	return n
+}
+
+func (x *RefreshTokenRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RefreshTokenRequest) sizeField1() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.RefreshToken)
+	return n
+}
+
+func (x *RefreshTokenRequest) sizeField2() (n int) {
+	if x.Client == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.Client)
+	return n
+}
+
+func (x *RefreshTokenResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *RefreshTokenResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *UserLoginRequest) FastWrite(buf []byte) (offset int) {
+func (x *RefreshTokenResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *RefreshTokenResponse) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.UserId)
+	return n
+}
+
+func (x *RefreshTokenResponse) sizeField4() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Token)
+	return n
+}
+
+func (x *RefreshTokenResponse) sizeField5() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.RefreshToken)
+	return n
+}
+
+func (x *LogoutRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *UserLoginRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *LogoutRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.Username)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *UserLoginRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
+func (x *LogoutRequest) sizeField2() (n int) {
+	if x.RefreshToken == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Password)
-	return offset
+	n += fastpb.SizeString(2, x.RefreshToken)
+	return n
+}
+
+func (x *LogoutResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *LogoutResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *LogoutResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *EnrollTOTPRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *EnrollTOTPRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *EnrollTOTPResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *EnrollTOTPResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *EnrollTOTPResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *EnrollTOTPResponse) sizeField3() (n int) {
+	if x.OtpauthUri == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.OtpauthUri)
+	return n
+}
+
+func (x *EnrollTOTPResponse) sizeField4() (n int) {
+	if x.Secret == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Secret)
+	return n
+}
+
+func (x *EnrollTOTPResponse) sizeField5() (n int) {
+	if len(x.RecoveryCodes) == 0 {
+		return n
+	}
+	for i := range x.RecoveryCodes {
+		n += fastpb.SizeString(5, x.RecoveryCodes[i])
+	}
+	return n
+}
+
+func (x *ConfirmTOTPRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ConfirmTOTPRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *ConfirmTOTPRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Code)
+	return n
+}
+
+func (x *ConfirmTOTPResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ConfirmTOTPResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *ConfirmTOTPResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *VerifyTOTPLoginRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *VerifyTOTPLoginRequest) sizeField1() (n int) {
+	if x.ChallengeToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.ChallengeToken)
+	return n
+}
+
+func (x *VerifyTOTPLoginRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Code)
+	return n
+}
+
+func (x *VerifyTOTPLoginRequest) sizeField3() (n int) {
+	if x.Client == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.Client)
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.UserId)
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) sizeField4() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Token)
+	return n
+}
+
+func (x *VerifyTOTPLoginResponse) sizeField5() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.RefreshToken)
+	return n
+}
+
+func (x *DisableTOTPRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DisableTOTPRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *DisableTOTPRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Code)
+	return n
 }
 
-func (x *UserLoginResponse) FastWrite(buf []byte) (offset int) {
+func (x *DisableTOTPResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *UserLoginResponse) fastWriteField1(buf []byte) (offset int) {
+func (x *DisableTOTPResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *UserLoginResponse) fastWriteField2(buf []byte) (offset int) {
+func (x *DisableTOTPResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *UserLoginResponse) fastWriteField3(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *Session) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 3, x.UserId)
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
 }
 
-func (x *UserLoginResponse) fastWriteField4(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *Session) sizeField1() (n int) {
+	if x.Id == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.Token)
-	return offset
+	n += fastpb.SizeString(1, x.Id)
+	return n
 }
 
-func (x *User) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Session) sizeField2() (n int) {
+	if x.Device == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	offset += x.fastWriteField7(buf[offset:])
-	offset += x.fastWriteField8(buf[offset:])
-	offset += x.fastWriteField9(buf[offset:])
-	offset += x.fastWriteField10(buf[offset:])
-	offset += x.fastWriteField11(buf[offset:])
-	return offset
+	n += fastpb.SizeString(2, x.Device)
+	return n
 }
 
-func (x *User) fastWriteField1(buf []byte) (offset int) {
-	if x.Id == 0 {
-		return offset
+func (x *Session) sizeField3() (n int) {
+	if x.Ip == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.Id)
-	return offset
+	n += fastpb.SizeString(3, x.Ip)
+	return n
 }
 
-func (x *User) fastWriteField2(buf []byte) (offset int) {
-	if x.Name == "" {
-		return offset
+func (x *Session) sizeField4() (n int) {
+	if x.UserAgent == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Name)
-	return offset
+	n += fastpb.SizeString(4, x.UserAgent)
+	return n
 }
 
-func (x *User) fastWriteField3(buf []byte) (offset int) {
-	if x.FollowCount == 0 {
-		return offset
+func (x *Session) sizeField5() (n int) {
+	if x.CreatedAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 3, x.FollowCount)
-	return offset
+	n += fastpb.SizeInt64(5, x.CreatedAt)
+	return n
 }
 
-func (x *User) fastWriteField4(buf []byte) (offset int) {
-	if x.FollowerCount == 0 {
-		return offset
+func (x *Session) sizeField6() (n int) {
+	if x.LastSeenAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 4, x.FollowerCount)
-	return offset
+	n += fastpb.SizeInt64(6, x.LastSeenAt)
+	return n
 }
 
-func (x *User) fastWriteField5(buf []byte) (offset int) {
-	if !x.IsFollow {
-		return offset
+func (x *Session) sizeField7() (n int) {
+	if !x.Current {
+		return n
 	}
-	offset += fastpb.WriteBool(buf[offset:], 5, x.IsFollow)
-	return offset
+	n += fastpb.SizeBool(7, x.Current)
+	return n
 }
 
-func (x *User) fastWriteField6(buf []byte) (offset int) {
-	if x.Avatar == "" {
-		return offset
+func (x *ListSessionsRequest) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 6, x.Avatar)
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *User) fastWriteField7(buf []byte) (offset int) {
-	if x.BackgroundImage == "" {
-		return offset
+func (x *ListSessionsRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 7, x.BackgroundImage)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *User) fastWriteField8(buf []byte) (offset int) {
-	if x.Signature == "" {
-		return offset
+func (x *ListSessionsResponse) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 8, x.Signature)
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *User) fastWriteField9(buf []byte) (offset int) {
-	if x.TotalFavorited == 0 {
-		return offset
+func (x *ListSessionsResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 9, x.TotalFavorited)
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *User) fastWriteField10(buf []byte) (offset int) {
-	if x.WorkCount == 0 {
-		return offset
+func (x *ListSessionsResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 10, x.WorkCount)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *User) fastWriteField11(buf []byte) (offset int) {
-	if x.FavoriteCount == 0 {
-		return offset
+func (x *ListSessionsResponse) sizeField3() (n int) {
+	if x.Sessions == nil {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 11, x.FavoriteCount)
-	return offset
+	for i := range x.Sessions {
+		n += fastpb.SizeMessage(3, x.Sessions[i])
+	}
+	return n
 }
 
-func (x *UserInfoRequest) FastWrite(buf []byte) (offset int) {
+func (x *RevokeSessionRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *UserInfoRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *RevokeSessionRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.UserId)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *UserInfoRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *RevokeSessionRequest) sizeField2() (n int) {
+	if x.SessionId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
-	return offset
+	n += fastpb.SizeString(2, x.SessionId)
+	return n
 }
 
-func (x *UserInfoResponse) FastWrite(buf []byte) (offset int) {
+func (x *RevokeSessionResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *UserInfoResponse) fastWriteField1(buf []byte) (offset int) {
+func (x *RevokeSessionResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *UserInfoResponse) fastWriteField2(buf []byte) (offset int) {
+func (x *RevokeSessionResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
-}
-
-func (x *UserInfoResponse) fastWriteField3(buf []byte) (offset int) {
-	if x.User == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 3, x.User)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *UserRegisterRequest) Size() (n int) {
+func (x *User) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
 	return n
 }
 
-func (x *UserRegisterRequest) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *User) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.Id)
+	return n
+}
+
+func (x *User) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Name)
+	return n
+}
+
+func (x *User) sizeField3() (n int) {
+	if x.FollowCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.FollowCount)
+	return n
+}
+
+func (x *User) sizeField4() (n int) {
+	if x.FollowerCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.FollowerCount)
+	return n
+}
+
+func (x *User) sizeField5() (n int) {
+	if !x.IsFollow {
 		return n
 	}
-	n += fastpb.SizeString(1, x.Username)
+	n += fastpb.SizeBool(5, x.IsFollow)
 	return n
 }
 
-func (x *UserRegisterRequest) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *User) sizeField6() (n int) {
+	if x.Avatar == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.Password)
+	n += fastpb.SizeString(6, x.Avatar)
 	return n
 }
 
-func (x *UserRegisterResponse) Size() (n int) {
-	if x == nil {
+func (x *User) sizeField7() (n int) {
+	if x.BackgroundImage == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
+	n += fastpb.SizeString(7, x.BackgroundImage)
 	return n
 }
 
-func (x *UserRegisterResponse) sizeField1() (n int) {
-	if x.StatusCode == 0 {
+func (x *User) sizeField8() (n int) {
+	if x.Signature == "" {
 		return n
 	}
-	n += fastpb.SizeInt32(1, x.StatusCode)
+	n += fastpb.SizeString(8, x.Signature)
 	return n
 }
 
-func (x *UserRegisterResponse) sizeField2() (n int) {
-	if x.StatusMsg == "" {
+func (x *User) sizeField9() (n int) {
+	if x.TotalFavorited == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.StatusMsg)
+	n += fastpb.SizeInt64(9, x.TotalFavorited)
 	return n
 }
 
-func (x *UserRegisterResponse) sizeField3() (n int) {
-	if x.UserId == 0 {
+func (x *User) sizeField10() (n int) {
+	if x.WorkCount == 0 {
 		return n
 	}
-	n += fastpb.SizeInt64(3, x.UserId)
+	n += fastpb.SizeInt64(10, x.WorkCount)
 	return n
 }
 
-func (x *UserRegisterResponse) sizeField4() (n int) {
-	if x.Token == "" {
+func (x *User) sizeField11() (n int) {
+	if x.FavoriteCount == 0 {
 		return n
 	}
-	n += fastpb.SizeString(4, x.Token)
+	n += fastpb.SizeInt64(11, x.FavoriteCount)
 	return n
 }
 
-func (x *UserLoginRequest) Size() (n int) {
+func (x *UserInfoRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -780,34 +3612,33 @@ func (x *UserLoginRequest) Size() (n int) {
 	return n
 }
 
-func (x *UserLoginRequest) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *UserInfoRequest) sizeField1() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(1, x.Username)
+	n += fastpb.SizeInt64(1, x.UserId)
 	return n
 }
 
-func (x *UserLoginRequest) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *UserInfoRequest) sizeField2() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.Password)
+	n += fastpb.SizeString(2, x.Token)
 	return n
 }
 
-func (x *UserLoginResponse) Size() (n int) {
+func (x *UserInfoResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *UserLoginResponse) sizeField1() (n int) {
+func (x *UserInfoResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
 		return n
 	}
@@ -815,7 +3646,7 @@ func (x *UserLoginResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *UserLoginResponse) sizeField2() (n int) {
+func (x *UserInfoResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
 		return n
 	}
@@ -823,164 +3654,187 @@ func (x *UserLoginResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *UserLoginResponse) sizeField3() (n int) {
-	if x.UserId == 0 {
+func (x *UserInfoResponse) sizeField3() (n int) {
+	if x.User == nil {
 		return n
 	}
-	n += fastpb.SizeInt64(3, x.UserId)
+	n += fastpb.SizeMessage(3, x.User)
 	return n
 }
 
-func (x *UserLoginResponse) sizeField4() (n int) {
+func (x *UpdateAvatarRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UpdateAvatarRequest) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.Token)
+	n += fastpb.SizeString(1, x.Token)
 	return n
 }
 
-func (x *User) Size() (n int) {
+func (x *UpdateAvatarRequest) sizeField2() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(2, x.Data)
+	return n
+}
+
+func (x *UpdateAvatarResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
-	n += x.sizeField6()
-	n += x.sizeField7()
-	n += x.sizeField8()
-	n += x.sizeField9()
-	n += x.sizeField10()
-	n += x.sizeField11()
 	return n
 }
 
-func (x *User) sizeField1() (n int) {
-	if x.Id == 0 {
+func (x *UpdateAvatarResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.Id)
+	n += fastpb.SizeInt32(1, x.StatusCode)
 	return n
 }
 
-func (x *User) sizeField2() (n int) {
-	if x.Name == "" {
+func (x *UpdateAvatarResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.Name)
+	n += fastpb.SizeString(2, x.StatusMsg)
 	return n
 }
 
-func (x *User) sizeField3() (n int) {
-	if x.FollowCount == 0 {
+func (x *UpdateAvatarResponse) sizeField3() (n int) {
+	if x.Avatar == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(3, x.FollowCount)
+	n += fastpb.SizeString(3, x.Avatar)
 	return n
 }
 
-func (x *User) sizeField4() (n int) {
-	if x.FollowerCount == 0 {
+func (x *UpdateBackgroundRequest) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeInt64(4, x.FollowerCount)
+	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *User) sizeField5() (n int) {
-	if !x.IsFollow {
+func (x *UpdateBackgroundRequest) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeBool(5, x.IsFollow)
+	n += fastpb.SizeString(1, x.Token)
 	return n
 }
 
-func (x *User) sizeField6() (n int) {
-	if x.Avatar == "" {
+func (x *UpdateBackgroundRequest) sizeField2() (n int) {
+	if len(x.Data) == 0 {
 		return n
 	}
-	n += fastpb.SizeString(6, x.Avatar)
+	n += fastpb.SizeBytes(2, x.Data)
 	return n
 }
 
-func (x *User) sizeField7() (n int) {
-	if x.BackgroundImage == "" {
+func (x *UpdateBackgroundResponse) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(7, x.BackgroundImage)
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
 	return n
 }
 
-func (x *User) sizeField8() (n int) {
-	if x.Signature == "" {
+func (x *UpdateBackgroundResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
 		return n
 	}
-	n += fastpb.SizeString(8, x.Signature)
+	n += fastpb.SizeInt32(1, x.StatusCode)
 	return n
 }
 
-func (x *User) sizeField9() (n int) {
-	if x.TotalFavorited == 0 {
+func (x *UpdateBackgroundResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(9, x.TotalFavorited)
+	n += fastpb.SizeString(2, x.StatusMsg)
 	return n
 }
 
-func (x *User) sizeField10() (n int) {
-	if x.WorkCount == 0 {
+func (x *UpdateBackgroundResponse) sizeField3() (n int) {
+	if x.BackgroundImage == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(10, x.WorkCount)
+	n += fastpb.SizeString(3, x.BackgroundImage)
 	return n
 }
 
-func (x *User) sizeField11() (n int) {
-	if x.FavoriteCount == 0 {
+func (x *UpdateProfileRequest) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeInt64(11, x.FavoriteCount)
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *UserInfoRequest) Size() (n int) {
-	if x == nil {
+func (x *UpdateProfileRequest) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeString(1, x.Token)
 	return n
 }
 
-func (x *UserInfoRequest) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *UpdateProfileRequest) sizeField2() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.UserId)
+	n += fastpb.SizeString(2, x.Name)
 	return n
 }
 
-func (x *UserInfoRequest) sizeField2() (n int) {
-	if x.Token == "" {
+func (x *UpdateProfileRequest) sizeField3() (n int) {
+	if x.Signature == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.Token)
+	n += fastpb.SizeString(3, x.Signature)
 	return n
 }
 
-func (x *UserInfoResponse) Size() (n int) {
+func (x *UpdateProfileRequest) sizeField4() (n int) {
+	if !x.SetSignature {
+		return n
+	}
+	n += fastpb.SizeBool(4, x.SetSignature)
+	return n
+}
+
+func (x *UpdateProfileResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *UserInfoResponse) sizeField1() (n int) {
+func (x *UpdateProfileResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
 		return n
 	}
@@ -988,7 +3842,7 @@ func (x *UserInfoResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *UserInfoResponse) sizeField2() (n int) {
+func (x *UpdateProfileResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
 		return n
 	}
@@ -996,17 +3850,26 @@ func (x *UserInfoResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *UserInfoResponse) sizeField3() (n int) {
-	if x.User == nil {
+func (x *UpdateProfileResponse) sizeField3() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(3, x.User)
+	n += fastpb.SizeString(3, x.Name)
+	return n
+}
+
+func (x *UpdateProfileResponse) sizeField4() (n int) {
+	if x.Signature == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.Signature)
 	return n
 }
 
 var fieldIDToName_UserRegisterRequest = map[int32]string{
 	1: "Username",
 	2: "Password",
+	3: "Client",
 }
 
 var fieldIDToName_UserRegisterResponse = map[int32]string{
@@ -1014,11 +3877,19 @@ var fieldIDToName_UserRegisterResponse = map[int32]string{
 	2: "StatusMsg",
 	3: "UserId",
 	4: "Token",
+	5: "RefreshToken",
+}
+
+var fieldIDToName_ClientInfo = map[int32]string{
+	1: "Device",
+	2: "Ip",
+	3: "UserAgent",
 }
 
 var fieldIDToName_UserLoginRequest = map[int32]string{
 	1: "Username",
 	2: "Password",
+	3: "Client",
 }
 
 var fieldIDToName_UserLoginResponse = map[int32]string{
@@ -1026,6 +3897,109 @@ var fieldIDToName_UserLoginResponse = map[int32]string{
 	2: "StatusMsg",
 	3: "UserId",
 	4: "Token",
+	5: "RefreshToken",
+	6: "RetryAfter",
+	7: "MfaRequired",
+	8: "ChallengeToken",
+}
+
+var fieldIDToName_RefreshTokenRequest = map[int32]string{
+	1: "RefreshToken",
+	2: "Client",
+}
+
+var fieldIDToName_RefreshTokenResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "UserId",
+	4: "Token",
+	5: "RefreshToken",
+}
+
+var fieldIDToName_LogoutRequest = map[int32]string{
+	1: "Token",
+	2: "RefreshToken",
+}
+
+var fieldIDToName_LogoutResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_EnrollTOTPRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_EnrollTOTPResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "OtpauthUri",
+	4: "Secret",
+	5: "RecoveryCodes",
+}
+
+var fieldIDToName_ConfirmTOTPRequest = map[int32]string{
+	1: "Token",
+	2: "Code",
+}
+
+var fieldIDToName_ConfirmTOTPResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_VerifyTOTPLoginRequest = map[int32]string{
+	1: "ChallengeToken",
+	2: "Code",
+	3: "Client",
+}
+
+var fieldIDToName_VerifyTOTPLoginResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "UserId",
+	4: "Token",
+	5: "RefreshToken",
+}
+
+var fieldIDToName_DisableTOTPRequest = map[int32]string{
+	1: "Token",
+	2: "Code",
+}
+
+var fieldIDToName_DisableTOTPResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_Session = map[int32]string{
+	1: "Id",
+	2: "Device",
+	3: "Ip",
+	4: "UserAgent",
+	5: "CreatedAt",
+	6: "LastSeenAt",
+	7: "Current",
+}
+
+var fieldIDToName_ListSessionsRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_ListSessionsResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Sessions",
+}
+
+var fieldIDToName_RevokeSessionRequest = map[int32]string{
+	1: "Token",
+	2: "SessionId",
+}
+
+var fieldIDToName_RevokeSessionResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
 }
 
 var fieldIDToName_User = map[int32]string{
@@ -1052,3 +4026,39 @@ var fieldIDToName_UserInfoResponse = map[int32]string{
 	2: "StatusMsg",
 	3: "User",
 }
+
+var fieldIDToName_UpdateAvatarRequest = map[int32]string{
+	1: "Token",
+	2: "Data",
+}
+
+var fieldIDToName_UpdateAvatarResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Avatar",
+}
+
+var fieldIDToName_UpdateBackgroundRequest = map[int32]string{
+	1: "Token",
+	2: "Data",
+}
+
+var fieldIDToName_UpdateBackgroundResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "BackgroundImage",
+}
+
+var fieldIDToName_UpdateProfileRequest = map[int32]string{
+	1: "Token",
+	2: "Name",
+	3: "Signature",
+	4: "SetSignature",
+}
+
+var fieldIDToName_UpdateProfileResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Name",
+	4: "Signature",
+}
@@ -14,6 +14,17 @@ type Client interface {
 	Register(ctx context.Context, Req *user.UserRegisterRequest, callOptions ...callopt.Option) (r *user.UserRegisterResponse, err error)
 	Login(ctx context.Context, Req *user.UserLoginRequest, callOptions ...callopt.Option) (r *user.UserLoginResponse, err error)
 	UserInfo(ctx context.Context, Req *user.UserInfoRequest, callOptions ...callopt.Option) (r *user.UserInfoResponse, err error)
+	RefreshToken(ctx context.Context, Req *user.RefreshTokenRequest, callOptions ...callopt.Option) (r *user.RefreshTokenResponse, err error)
+	Logout(ctx context.Context, Req *user.LogoutRequest, callOptions ...callopt.Option) (r *user.LogoutResponse, err error)
+	ListSessions(ctx context.Context, Req *user.ListSessionsRequest, callOptions ...callopt.Option) (r *user.ListSessionsResponse, err error)
+	RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest, callOptions ...callopt.Option) (r *user.RevokeSessionResponse, err error)
+	EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest, callOptions ...callopt.Option) (r *user.EnrollTOTPResponse, err error)
+	ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest, callOptions ...callopt.Option) (r *user.ConfirmTOTPResponse, err error)
+	VerifyTOTPLogin(ctx context.Context, Req *user.VerifyTOTPLoginRequest, callOptions ...callopt.Option) (r *user.VerifyTOTPLoginResponse, err error)
+	DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest, callOptions ...callopt.Option) (r *user.DisableTOTPResponse, err error)
+	UpdateAvatar(ctx context.Context, Req *user.UpdateAvatarRequest, callOptions ...callopt.Option) (r *user.UpdateAvatarResponse, err error)
+	UpdateBackground(ctx context.Context, Req *user.UpdateBackgroundRequest, callOptions ...callopt.Option) (r *user.UpdateBackgroundResponse, err error)
+	UpdateProfile(ctx context.Context, Req *user.UpdateProfileRequest, callOptions ...callopt.Option) (r *user.UpdateProfileResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +70,58 @@ func (p *kUserServiceClient) UserInfo(ctx context.Context, Req *user.UserInfoReq
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UserInfo(ctx, Req)
 }
+
+func (p *kUserServiceClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenRequest, callOptions ...callopt.Option) (r *user.RefreshTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) Logout(ctx context.Context, Req *user.LogoutRequest, callOptions ...callopt.Option) (r *user.LogoutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListSessions(ctx context.Context, Req *user.ListSessionsRequest, callOptions ...callopt.Option) (r *user.ListSessionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest, callOptions ...callopt.Option) (r *user.RevokeSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeSession(ctx, Req)
+}
+
+func (p *kUserServiceClient) EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest, callOptions ...callopt.Option) (r *user.EnrollTOTPResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest, callOptions ...callopt.Option) (r *user.ConfirmTOTPResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyTOTPLogin(ctx context.Context, Req *user.VerifyTOTPLoginRequest, callOptions ...callopt.Option) (r *user.VerifyTOTPLoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyTOTPLogin(ctx, Req)
+}
+
+func (p *kUserServiceClient) DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest, callOptions ...callopt.Option) (r *user.DisableTOTPResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateAvatar(ctx context.Context, Req *user.UpdateAvatarRequest, callOptions ...callopt.Option) (r *user.UpdateAvatarResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAvatar(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateBackground(ctx context.Context, Req *user.UpdateBackgroundRequest, callOptions ...callopt.Option) (r *user.UpdateBackgroundResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateBackground(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileRequest, callOptions ...callopt.Option) (r *user.UpdateProfileResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProfile(ctx, Req)
+}
@@ -22,9 +22,20 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "UserService"
 	handlerType := (*user.UserService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Register": kitex.NewMethodInfo(registerHandler, newRegisterArgs, newRegisterResult, false),
-		"Login":    kitex.NewMethodInfo(loginHandler, newLoginArgs, newLoginResult, false),
-		"UserInfo": kitex.NewMethodInfo(userInfoHandler, newUserInfoArgs, newUserInfoResult, false),
+		"Register":         kitex.NewMethodInfo(registerHandler, newRegisterArgs, newRegisterResult, false),
+		"Login":            kitex.NewMethodInfo(loginHandler, newLoginArgs, newLoginResult, false),
+		"UserInfo":         kitex.NewMethodInfo(userInfoHandler, newUserInfoArgs, newUserInfoResult, false),
+		"RefreshToken":     kitex.NewMethodInfo(refreshTokenHandler, newRefreshTokenArgs, newRefreshTokenResult, false),
+		"Logout":           kitex.NewMethodInfo(logoutHandler, newLogoutArgs, newLogoutResult, false),
+		"ListSessions":     kitex.NewMethodInfo(listSessionsHandler, newListSessionsArgs, newListSessionsResult, false),
+		"RevokeSession":    kitex.NewMethodInfo(revokeSessionHandler, newRevokeSessionArgs, newRevokeSessionResult, false),
+		"EnrollTOTP":       kitex.NewMethodInfo(enrollTOTPHandler, newEnrollTOTPArgs, newEnrollTOTPResult, false),
+		"ConfirmTOTP":      kitex.NewMethodInfo(confirmTOTPHandler, newConfirmTOTPArgs, newConfirmTOTPResult, false),
+		"VerifyTOTPLogin":  kitex.NewMethodInfo(verifyTOTPLoginHandler, newVerifyTOTPLoginArgs, newVerifyTOTPLoginResult, false),
+		"DisableTOTP":      kitex.NewMethodInfo(disableTOTPHandler, newDisableTOTPArgs, newDisableTOTPResult, false),
+		"UpdateAvatar":     kitex.NewMethodInfo(updateAvatarHandler, newUpdateAvatarArgs, newUpdateAvatarResult, false),
+		"UpdateBackground": kitex.NewMethodInfo(updateBackgroundHandler, newUpdateBackgroundArgs, newUpdateBackgroundResult, false),
+		"UpdateProfile":    kitex.NewMethodInfo(updateProfileHandler, newUpdateProfileArgs, newUpdateProfileResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "user",
@@ -475,6 +486,1601 @@ func (p *UserInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RefreshTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RefreshToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RefreshTokenArgs:
+		success, err := handler.(user.UserService).RefreshToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefreshTokenResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRefreshTokenArgs() interface{} {
+	return &RefreshTokenArgs{}
+}
+
+func newRefreshTokenResult() interface{} {
+	return &RefreshTokenResult{}
+}
+
+type RefreshTokenArgs struct {
+	Req *user.RefreshTokenRequest
+}
+
+func (p *RefreshTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RefreshTokenRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefreshTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefreshTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in RefreshTokenArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefreshTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefreshTokenArgs_Req_DEFAULT *user.RefreshTokenRequest
+
+func (p *RefreshTokenArgs) GetReq() *user.RefreshTokenRequest {
+	if !p.IsSetReq() {
+		return RefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type RefreshTokenResult struct {
+	Success *user.RefreshTokenResponse
+}
+
+var RefreshTokenResult_Success_DEFAULT *user.RefreshTokenResponse
+
+func (p *RefreshTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RefreshTokenResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefreshTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefreshTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in RefreshTokenResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefreshTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefreshTokenResult) GetSuccess() *user.RefreshTokenResponse {
+	if !p.IsSetSuccess() {
+		return RefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RefreshTokenResponse)
+}
+
+func (p *RefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LogoutRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Logout(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *LogoutArgs:
+		success, err := handler.(user.UserService).Logout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newLogoutArgs() interface{} {
+	return &LogoutArgs{}
+}
+
+func newLogoutResult() interface{} {
+	return &LogoutResult{}
+}
+
+type LogoutArgs struct {
+	Req *user.LogoutRequest
+}
+
+func (p *LogoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.LogoutRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LogoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LogoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LogoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in LogoutArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutArgs) Unmarshal(in []byte) error {
+	msg := new(user.LogoutRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutArgs_Req_DEFAULT *user.LogoutRequest
+
+func (p *LogoutArgs) GetReq() *user.LogoutRequest {
+	if !p.IsSetReq() {
+		return LogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type LogoutResult struct {
+	Success *user.LogoutResponse
+}
+
+var LogoutResult_Success_DEFAULT *user.LogoutResponse
+
+func (p *LogoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LogoutResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LogoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LogoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LogoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in LogoutResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutResult) Unmarshal(in []byte) error {
+	msg := new(user.LogoutResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutResult) GetSuccess() *user.LogoutResponse {
+	if !p.IsSetSuccess() {
+		return LogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LogoutResponse)
+}
+
+func (p *LogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListSessionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListSessions(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListSessionsArgs:
+		success, err := handler.(user.UserService).ListSessions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListSessionsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListSessionsArgs() interface{} {
+	return &ListSessionsArgs{}
+}
+
+func newListSessionsResult() interface{} {
+	return &ListSessionsResult{}
+}
+
+type ListSessionsArgs struct {
+	Req *user.ListSessionsRequest
+}
+
+func (p *ListSessionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListSessionsRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListSessionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListSessionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in ListSessionsArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListSessionsArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListSessionsArgs_Req_DEFAULT *user.ListSessionsRequest
+
+func (p *ListSessionsArgs) GetReq() *user.ListSessionsRequest {
+	if !p.IsSetReq() {
+		return ListSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type ListSessionsResult struct {
+	Success *user.ListSessionsResponse
+}
+
+var ListSessionsResult_Success_DEFAULT *user.ListSessionsResponse
+
+func (p *ListSessionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListSessionsResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListSessionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListSessionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in ListSessionsResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListSessionsResult) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListSessionsResult) GetSuccess() *user.ListSessionsResponse {
+	if !p.IsSetSuccess() {
+		return ListSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListSessionsResponse)
+}
+
+func (p *ListSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func revokeSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeSessionRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeSession(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RevokeSessionArgs:
+		success, err := handler.(user.UserService).RevokeSession(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeSessionResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRevokeSessionArgs() interface{} {
+	return &RevokeSessionArgs{}
+}
+
+func newRevokeSessionResult() interface{} {
+	return &RevokeSessionResult{}
+}
+
+type RevokeSessionArgs struct {
+	Req *user.RevokeSessionRequest
+}
+
+func (p *RevokeSessionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RevokeSessionRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeSessionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeSessionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeSessionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in RevokeSessionArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeSessionArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeSessionRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeSessionArgs_Req_DEFAULT *user.RevokeSessionRequest
+
+func (p *RevokeSessionArgs) GetReq() *user.RevokeSessionRequest {
+	if !p.IsSetReq() {
+		return RevokeSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type RevokeSessionResult struct {
+	Success *user.RevokeSessionResponse
+}
+
+var RevokeSessionResult_Success_DEFAULT *user.RevokeSessionResponse
+
+func (p *RevokeSessionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RevokeSessionResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeSessionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeSessionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeSessionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in RevokeSessionResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeSessionResult) Unmarshal(in []byte) error {
+	msg := new(user.RevokeSessionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeSessionResult) GetSuccess() *user.RevokeSessionResponse {
+	if !p.IsSetSuccess() {
+		return RevokeSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RevokeSessionResponse)
+}
+
+func (p *RevokeSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func enrollTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.EnrollTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).EnrollTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EnrollTOTPArgs:
+		success, err := handler.(user.UserService).EnrollTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EnrollTOTPResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEnrollTOTPArgs() interface{} {
+	return &EnrollTOTPArgs{}
+}
+
+func newEnrollTOTPResult() interface{} {
+	return &EnrollTOTPResult{}
+}
+
+type EnrollTOTPArgs struct {
+	Req *user.EnrollTOTPRequest
+}
+
+func (p *EnrollTOTPArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.EnrollTOTPRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTOTPArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EnrollTOTPArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EnrollTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in EnrollTOTPArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EnrollTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EnrollTOTPArgs_Req_DEFAULT *user.EnrollTOTPRequest
+
+func (p *EnrollTOTPArgs) GetReq() *user.EnrollTOTPRequest {
+	if !p.IsSetReq() {
+		return EnrollTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EnrollTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type EnrollTOTPResult struct {
+	Success *user.EnrollTOTPResponse
+}
+
+var EnrollTOTPResult_Success_DEFAULT *user.EnrollTOTPResponse
+
+func (p *EnrollTOTPResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.EnrollTOTPResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTOTPResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EnrollTOTPResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EnrollTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in EnrollTOTPResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EnrollTOTPResult) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTOTPResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EnrollTOTPResult) GetSuccess() *user.EnrollTOTPResponse {
+	if !p.IsSetSuccess() {
+		return EnrollTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EnrollTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.EnrollTOTPResponse)
+}
+
+func (p *EnrollTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func confirmTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ConfirmTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ConfirmTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ConfirmTOTPArgs:
+		success, err := handler.(user.UserService).ConfirmTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmTOTPResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newConfirmTOTPArgs() interface{} {
+	return &ConfirmTOTPArgs{}
+}
+
+func newConfirmTOTPResult() interface{} {
+	return &ConfirmTOTPResult{}
+}
+
+type ConfirmTOTPArgs struct {
+	Req *user.ConfirmTOTPRequest
+}
+
+func (p *ConfirmTOTPArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ConfirmTOTPRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTOTPArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmTOTPArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in ConfirmTOTPArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmTOTPArgs_Req_DEFAULT *user.ConfirmTOTPRequest
+
+func (p *ConfirmTOTPArgs) GetReq() *user.ConfirmTOTPRequest {
+	if !p.IsSetReq() {
+		return ConfirmTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type ConfirmTOTPResult struct {
+	Success *user.ConfirmTOTPResponse
+}
+
+var ConfirmTOTPResult_Success_DEFAULT *user.ConfirmTOTPResponse
+
+func (p *ConfirmTOTPResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ConfirmTOTPResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTOTPResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmTOTPResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in ConfirmTOTPResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmTOTPResult) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmTOTPResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmTOTPResult) GetSuccess() *user.ConfirmTOTPResponse {
+	if !p.IsSetSuccess() {
+		return ConfirmTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ConfirmTOTPResponse)
+}
+
+func (p *ConfirmTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func verifyTOTPLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyTOTPLoginRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyTOTPLogin(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *VerifyTOTPLoginArgs:
+		success, err := handler.(user.UserService).VerifyTOTPLogin(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyTOTPLoginResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newVerifyTOTPLoginArgs() interface{} {
+	return &VerifyTOTPLoginArgs{}
+}
+
+func newVerifyTOTPLoginResult() interface{} {
+	return &VerifyTOTPLoginResult{}
+}
+
+type VerifyTOTPLoginArgs struct {
+	Req *user.VerifyTOTPLoginRequest
+}
+
+func (p *VerifyTOTPLoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.VerifyTOTPLoginRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTOTPLoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyTOTPLoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyTOTPLoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in VerifyTOTPLoginArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyTOTPLoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyTOTPLoginRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyTOTPLoginArgs_Req_DEFAULT *user.VerifyTOTPLoginRequest
+
+func (p *VerifyTOTPLoginArgs) GetReq() *user.VerifyTOTPLoginRequest {
+	if !p.IsSetReq() {
+		return VerifyTOTPLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyTOTPLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type VerifyTOTPLoginResult struct {
+	Success *user.VerifyTOTPLoginResponse
+}
+
+var VerifyTOTPLoginResult_Success_DEFAULT *user.VerifyTOTPLoginResponse
+
+func (p *VerifyTOTPLoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.VerifyTOTPLoginResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTOTPLoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyTOTPLoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyTOTPLoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in VerifyTOTPLoginResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyTOTPLoginResult) Unmarshal(in []byte) error {
+	msg := new(user.VerifyTOTPLoginResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyTOTPLoginResult) GetSuccess() *user.VerifyTOTPLoginResponse {
+	if !p.IsSetSuccess() {
+		return VerifyTOTPLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyTOTPLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.VerifyTOTPLoginResponse)
+}
+
+func (p *VerifyTOTPLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func disableTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DisableTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DisableTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DisableTOTPArgs:
+		success, err := handler.(user.UserService).DisableTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DisableTOTPResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDisableTOTPArgs() interface{} {
+	return &DisableTOTPArgs{}
+}
+
+func newDisableTOTPResult() interface{} {
+	return &DisableTOTPResult{}
+}
+
+type DisableTOTPArgs struct {
+	Req *user.DisableTOTPRequest
+}
+
+func (p *DisableTOTPArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DisableTOTPRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DisableTOTPArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DisableTOTPArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DisableTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in DisableTOTPArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DisableTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.DisableTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DisableTOTPArgs_Req_DEFAULT *user.DisableTOTPRequest
+
+func (p *DisableTOTPArgs) GetReq() *user.DisableTOTPRequest {
+	if !p.IsSetReq() {
+		return DisableTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DisableTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type DisableTOTPResult struct {
+	Success *user.DisableTOTPResponse
+}
+
+var DisableTOTPResult_Success_DEFAULT *user.DisableTOTPResponse
+
+func (p *DisableTOTPResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DisableTOTPResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DisableTOTPResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DisableTOTPResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DisableTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in DisableTOTPResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DisableTOTPResult) Unmarshal(in []byte) error {
+	msg := new(user.DisableTOTPResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DisableTOTPResult) GetSuccess() *user.DisableTOTPResponse {
+	if !p.IsSetSuccess() {
+		return DisableTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DisableTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DisableTOTPResponse)
+}
+
+func (p *DisableTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func updateAvatarHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateAvatarRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateAvatar(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateAvatarArgs:
+		success, err := handler.(user.UserService).UpdateAvatar(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateAvatarResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateAvatarArgs() interface{} {
+	return &UpdateAvatarArgs{}
+}
+
+func newUpdateAvatarResult() interface{} {
+	return &UpdateAvatarResult{}
+}
+
+type UpdateAvatarArgs struct {
+	Req *user.UpdateAvatarRequest
+}
+
+func (p *UpdateAvatarArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateAvatarRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAvatarArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateAvatarArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateAvatarArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UpdateAvatarArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateAvatarArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAvatarRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateAvatarArgs_Req_DEFAULT *user.UpdateAvatarRequest
+
+func (p *UpdateAvatarArgs) GetReq() *user.UpdateAvatarRequest {
+	if !p.IsSetReq() {
+		return UpdateAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UpdateAvatarResult struct {
+	Success *user.UpdateAvatarResponse
+}
+
+var UpdateAvatarResult_Success_DEFAULT *user.UpdateAvatarResponse
+
+func (p *UpdateAvatarResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateAvatarResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAvatarResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateAvatarResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateAvatarResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UpdateAvatarResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateAvatarResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAvatarResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateAvatarResult) GetSuccess() *user.UpdateAvatarResponse {
+	if !p.IsSetSuccess() {
+		return UpdateAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateAvatarResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateAvatarResponse)
+}
+
+func (p *UpdateAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func updateBackgroundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateBackgroundRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateBackground(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateBackgroundArgs:
+		success, err := handler.(user.UserService).UpdateBackground(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateBackgroundResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateBackgroundArgs() interface{} {
+	return &UpdateBackgroundArgs{}
+}
+
+func newUpdateBackgroundResult() interface{} {
+	return &UpdateBackgroundResult{}
+}
+
+type UpdateBackgroundArgs struct {
+	Req *user.UpdateBackgroundRequest
+}
+
+func (p *UpdateBackgroundArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateBackgroundRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateBackgroundArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateBackgroundArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateBackgroundArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UpdateBackgroundArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateBackgroundArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateBackgroundRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateBackgroundArgs_Req_DEFAULT *user.UpdateBackgroundRequest
+
+func (p *UpdateBackgroundArgs) GetReq() *user.UpdateBackgroundRequest {
+	if !p.IsSetReq() {
+		return UpdateBackgroundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateBackgroundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UpdateBackgroundResult struct {
+	Success *user.UpdateBackgroundResponse
+}
+
+var UpdateBackgroundResult_Success_DEFAULT *user.UpdateBackgroundResponse
+
+func (p *UpdateBackgroundResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateBackgroundResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateBackgroundResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateBackgroundResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateBackgroundResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UpdateBackgroundResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateBackgroundResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateBackgroundResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateBackgroundResult) GetSuccess() *user.UpdateBackgroundResponse {
+	if !p.IsSetSuccess() {
+		return UpdateBackgroundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateBackgroundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateBackgroundResponse)
+}
+
+func (p *UpdateBackgroundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func updateProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateProfileRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateProfile(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateProfileArgs:
+		success, err := handler.(user.UserService).UpdateProfile(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateProfileResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateProfileArgs() interface{} {
+	return &UpdateProfileArgs{}
+}
+
+func newUpdateProfileResult() interface{} {
+	return &UpdateProfileResult{}
+}
+
+type UpdateProfileArgs struct {
+	Req *user.UpdateProfileRequest
+}
+
+func (p *UpdateProfileArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateProfileRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateProfileArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateProfileArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UpdateProfileArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateProfileArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateProfileArgs_Req_DEFAULT *user.UpdateProfileRequest
+
+func (p *UpdateProfileArgs) GetReq() *user.UpdateProfileRequest {
+	if !p.IsSetReq() {
+		return UpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UpdateProfileResult struct {
+	Success *user.UpdateProfileResponse
+}
+
+var UpdateProfileResult_Success_DEFAULT *user.UpdateProfileResponse
+
+func (p *UpdateProfileResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateProfileResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateProfileResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateProfileResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UpdateProfileResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateProfileResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateProfileResult) GetSuccess() *user.UpdateProfileResponse {
+	if !p.IsSetSuccess() {
+		return UpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateProfileResponse)
+}
+
+func (p *UpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -514,3 +2120,113 @@ func (p *kClient) UserInfo(ctx context.Context, Req *user.UserInfoRequest) (r *u
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenRequest) (r *user.RefreshTokenResponse, err error) {
+	var _args RefreshTokenArgs
+	_args.Req = Req
+	var _result RefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, Req *user.LogoutRequest) (r *user.LogoutResponse, err error) {
+	var _args LogoutArgs
+	_args.Req = Req
+	var _result LogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSessions(ctx context.Context, Req *user.ListSessionsRequest) (r *user.ListSessionsResponse, err error) {
+	var _args ListSessionsArgs
+	_args.Req = Req
+	var _result ListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest) (r *user.RevokeSessionResponse, err error) {
+	var _args RevokeSessionArgs
+	_args.Req = Req
+	var _result RevokeSessionResult
+	if err = p.c.Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest) (r *user.EnrollTOTPResponse, err error) {
+	var _args EnrollTOTPArgs
+	_args.Req = Req
+	var _result EnrollTOTPResult
+	if err = p.c.Call(ctx, "EnrollTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest) (r *user.ConfirmTOTPResponse, err error) {
+	var _args ConfirmTOTPArgs
+	_args.Req = Req
+	var _result ConfirmTOTPResult
+	if err = p.c.Call(ctx, "ConfirmTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyTOTPLogin(ctx context.Context, Req *user.VerifyTOTPLoginRequest) (r *user.VerifyTOTPLoginResponse, err error) {
+	var _args VerifyTOTPLoginArgs
+	_args.Req = Req
+	var _result VerifyTOTPLoginResult
+	if err = p.c.Call(ctx, "VerifyTOTPLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest) (r *user.DisableTOTPResponse, err error) {
+	var _args DisableTOTPArgs
+	_args.Req = Req
+	var _result DisableTOTPResult
+	if err = p.c.Call(ctx, "DisableTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAvatar(ctx context.Context, Req *user.UpdateAvatarRequest) (r *user.UpdateAvatarResponse, err error) {
+	var _args UpdateAvatarArgs
+	_args.Req = Req
+	var _result UpdateAvatarResult
+	if err = p.c.Call(ctx, "UpdateAvatar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateBackground(ctx context.Context, Req *user.UpdateBackgroundRequest) (r *user.UpdateBackgroundResponse, err error) {
+	var _args UpdateBackgroundArgs
+	_args.Req = Req
+	var _result UpdateBackgroundResult
+	if err = p.c.Call(ctx, "UpdateBackground", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileRequest) (r *user.UpdateProfileResponse, err error) {
+	var _args UpdateProfileArgs
+	_args.Req = Req
+	var _result UpdateProfileResult
+	if err = p.c.Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
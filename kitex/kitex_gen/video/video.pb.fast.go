@@ -60,6 +60,51 @@ func (x *Video) FastRead(buf []byte, _type int8, number int32) (offset int, err
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 16:
+		offset, err = x.fastReadField16(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 17:
+		offset, err = x.fastReadField17(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 18:
+		offset, err = x.fastReadField18(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -123,6 +168,91 @@ func (x *Video) fastReadField9(buf []byte, _type int8) (offset int, err error) {
 	return offset, err
 }
 
+func (x *Video) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.Duration, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.Width, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.Height, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.Codec, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	var v CoverThumbnail
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.CoverThumbnails = append(x.CoverThumbnails, &v)
+	return offset, nil
+}
+
+func (x *Video) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.PlayCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField16(buf []byte, _type int8) (offset int, err error) {
+	x.Visibility, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField17(buf []byte, _type int8) (offset int, err error) {
+	x.PublishStatus, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Video) fastReadField18(buf []byte, _type int8) (offset int, err error) {
+	x.PublishAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CoverThumbnail) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CoverThumbnail[number], err)
+}
+
+func (x *CoverThumbnail) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Width, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CoverThumbnail) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Url, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *FeedRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -135,6 +265,21 @@ func (x *FeedRequest) FastRead(buf []byte, _type int8, number int32) (offset int
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -158,6 +303,21 @@ func (x *FeedRequest) fastReadField2(buf []byte, _type int8) (offset int, err er
 	return offset, err
 }
 
+func (x *FeedRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.FeedMode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *FeedRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.FeedType, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *FeedRequest) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *FeedResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -180,6 +340,11 @@ func (x *FeedResponse) FastRead(buf []byte, _type int8, number int32) (offset in
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -218,6 +383,11 @@ func (x *FeedResponse) fastReadField4(buf []byte, _type int8) (offset int, err e
 	return offset, err
 }
 
+func (x *FeedResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *PublishActionRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -235,6 +405,26 @@ func (x *PublishActionRequest) FastRead(buf []byte, _type int8, number int32) (o
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -263,6 +453,26 @@ func (x *PublishActionRequest) fastReadField3(buf []byte, _type int8) (offset in
 	return offset, err
 }
 
+func (x *PublishActionRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Cover, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *PublishActionRequest) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Visibility, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PublishActionRequest) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Draft, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *PublishActionRequest) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.PublishAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
 func (x *PublishActionResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -275,6 +485,11 @@ func (x *PublishActionResponse) FastRead(buf []byte, _type int8, number int32) (
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -298,6 +513,11 @@ func (x *PublishActionResponse) fastReadField2(buf []byte, _type int8) (offset i
 	return offset, err
 }
 
+func (x *PublishActionResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
 func (x *PublishListRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -310,6 +530,11 @@ func (x *PublishListRequest) FastRead(buf []byte, _type int8, number int32) (off
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -333,6 +558,11 @@ func (x *PublishListRequest) fastReadField2(buf []byte, _type int8) (offset int,
 	return offset, err
 }
 
+func (x *PublishListRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *PublishListResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -350,6 +580,11 @@ func (x *PublishListResponse) FastRead(buf []byte, _type int8, number int32) (of
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -383,409 +618,4359 @@ func (x *PublishListResponse) fastReadField3(buf []byte, _type int8) (offset int
 	return offset, nil
 }
 
-func (x *Video) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	offset += x.fastWriteField7(buf[offset:])
-	offset += x.fastWriteField8(buf[offset:])
-	offset += x.fastWriteField9(buf[offset:])
-	return offset
+func (x *PublishListResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *Video) fastWriteField1(buf []byte) (offset int) {
-	if x.Id == 0 {
-		return offset
+func (x *UploadInitRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.Id)
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadInitRequest[number], err)
 }
 
-func (x *Video) fastWriteField2(buf []byte) (offset int) {
-	if x.Author == nil {
-		return offset
-	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.Author)
-	return offset
+func (x *UploadInitRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *Video) fastWriteField3(buf []byte) (offset int) {
-	if x.PlayUrl == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.PlayUrl)
-	return offset
+func (x *UploadInitRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Title, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.FileSize, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UploadId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Visibility, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Draft, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.PublishAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitRequest) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.FileName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadInitResponse[number], err)
+}
+
+func (x *UploadInitResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UploadId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PartSize, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.PartCount, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadInitResponse) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v int32
+			v, offset, err = fastpb.ReadInt32(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.UploadedParts = append(x.UploadedParts, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *UploadPartRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadPartRequest[number], err)
+}
+
+func (x *UploadPartRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UploadId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PartNumber, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadPartResponse[number], err)
+}
+
+func (x *UploadPartResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadPartResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PartNumber, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadCompleteRequest[number], err)
+}
+
+func (x *UploadCompleteRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UploadId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cover, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadCompleteResponse[number], err)
+}
+
+func (x *UploadCompleteResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadCompleteResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAbortRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadAbortRequest[number], err)
+}
+
+func (x *UploadAbortRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAbortRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UploadId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAbortResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadAbortResponse[number], err)
+}
+
+func (x *UploadAbortResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAbortResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PublishStatusRequest[number], err)
+}
+
+func (x *PublishStatusRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PublishStatusResponse[number], err)
+}
+
+func (x *PublishStatusResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProcessStatus, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Retries, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PublishStatusResponse) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.FailReason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DraftListRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DraftListRequest[number], err)
+}
+
+func (x *DraftListRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DraftListRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DraftListResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DraftListResponse[number], err)
+}
+
+func (x *DraftListResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *DraftListResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DraftListResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Video
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.VideoList = append(x.VideoList, &v)
+	return offset, nil
+}
+
+func (x *DraftListResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PublishDraftRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PublishDraftRequest[number], err)
+}
+
+func (x *PublishDraftRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PublishDraftRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PublishDraftRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PublishAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PublishDraftResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PublishDraftResponse[number], err)
+}
+
+func (x *PublishDraftResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PublishDraftResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteVideoRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteVideoRequest[number], err)
+}
+
+func (x *DeleteVideoRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteVideoRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteVideoResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteVideoResponse[number], err)
+}
+
+func (x *DeleteVideoResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteVideoResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateVideoRequest[number], err)
+}
+
+func (x *UpdateVideoRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Title, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Visibility, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateVideoResponse[number], err)
+}
+
+func (x *UpdateVideoResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateVideoResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PlayActionRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PlayActionRequest[number], err)
+}
+
+func (x *PlayActionRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PlayActionRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PlayActionRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.WatchDuration, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *PlayActionResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PlayActionResponse[number], err)
+}
+
+func (x *PlayActionResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *PlayActionResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Tag) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Tag[number], err)
+}
+
+func (x *Tag) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Tag) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Tag) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.VideoCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Tag) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Score, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TopicFeedRequest[number], err)
+}
+
+func (x *TopicFeedRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Tag, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TopicFeedResponse[number], err)
+}
+
+func (x *TopicFeedResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TopicFeedResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Tag
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Tag = &v
+	return offset, nil
+}
+
+func (x *TopicFeedResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v Video
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.VideoList = append(x.VideoList, &v)
+	return offset, nil
+}
+
+func (x *TopicFeedResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TrendingTagsRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TrendingTagsRequest[number], err)
+}
+
+func (x *TrendingTagsRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *TrendingTagsResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TrendingTagsResponse[number], err)
+}
+
+func (x *TrendingTagsResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *TrendingTagsResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TrendingTagsResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Tag
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Tags = append(x.Tags, &v)
+	return offset, nil
+}
+
+func (x *ShareVideoRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShareVideoRequest[number], err)
+}
+
+func (x *ShareVideoRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.VideoId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Channel, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShareVideoResponse[number], err)
+}
+
+func (x *ShareVideoResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ShareCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ShareUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShareVideoResponse) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.ShareCount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ResolveShareRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResolveShareRequest[number], err)
+}
+
+func (x *ResolveShareRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ShareCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResolveShareRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResolveShareResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResolveShareResponse[number], err)
+}
+
+func (x *ResolveShareResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StatusCode, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ResolveShareResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StatusMsg, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResolveShareResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Video
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Video = &v
+	return offset, nil
+}
+
+func (x *ResolveShareResponse) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.SharerId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Video) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	offset += x.fastWriteField16(buf[offset:])
+	offset += x.fastWriteField17(buf[offset:])
+	offset += x.fastWriteField18(buf[offset:])
+	return offset
+}
+
+func (x *Video) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.Id)
+	return offset
+}
+
+func (x *Video) fastWriteField2(buf []byte) (offset int) {
+	if x.Author == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.Author)
+	return offset
+}
+
+func (x *Video) fastWriteField3(buf []byte) (offset int) {
+	if x.PlayUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.PlayUrl)
+	return offset
+}
+
+func (x *Video) fastWriteField4(buf []byte) (offset int) {
+	if x.CoverUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.CoverUrl)
+	return offset
+}
+
+func (x *Video) fastWriteField5(buf []byte) (offset int) {
+	if x.FavoriteCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.FavoriteCount)
+	return offset
+}
+
+func (x *Video) fastWriteField6(buf []byte) (offset int) {
+	if x.CommentCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.CommentCount)
+	return offset
+}
+
+func (x *Video) fastWriteField7(buf []byte) (offset int) {
+	if !x.IsFavorite {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.IsFavorite)
+	return offset
+}
+
+func (x *Video) fastWriteField8(buf []byte) (offset int) {
+	if x.Title == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.Title)
+	return offset
+}
+
+func (x *Video) fastWriteField9(buf []byte) (offset int) {
+	if x.ShareCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 9, x.ShareCount)
+	return offset
+}
+
+func (x *Video) fastWriteField10(buf []byte) (offset int) {
+	if x.Duration == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 10, x.Duration)
+	return offset
+}
+
+func (x *Video) fastWriteField11(buf []byte) (offset int) {
+	if x.Width == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 11, x.Width)
+	return offset
+}
+
+func (x *Video) fastWriteField12(buf []byte) (offset int) {
+	if x.Height == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 12, x.Height)
+	return offset
+}
+
+func (x *Video) fastWriteField13(buf []byte) (offset int) {
+	if x.Codec == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 13, x.Codec)
+	return offset
+}
+
+func (x *Video) fastWriteField14(buf []byte) (offset int) {
+	if x.CoverThumbnails == nil {
+		return offset
+	}
+	for i := range x.CoverThumbnails {
+		offset += fastpb.WriteMessage(buf[offset:], 14, x.CoverThumbnails[i])
+	}
+	return offset
+}
+
+func (x *Video) fastWriteField15(buf []byte) (offset int) {
+	if x.PlayCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 15, x.PlayCount)
+	return offset
+}
+
+func (x *Video) fastWriteField16(buf []byte) (offset int) {
+	if x.Visibility == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 16, x.Visibility)
+	return offset
+}
+
+func (x *Video) fastWriteField17(buf []byte) (offset int) {
+	if x.PublishStatus == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 17, x.PublishStatus)
+	return offset
+}
+
+func (x *Video) fastWriteField18(buf []byte) (offset int) {
+	if x.PublishAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 18, x.PublishAt)
+	return offset
+}
+
+func (x *CoverThumbnail) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CoverThumbnail) fastWriteField1(buf []byte) (offset int) {
+	if x.Width == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.Width)
+	return offset
+}
+
+func (x *CoverThumbnail) fastWriteField2(buf []byte) (offset int) {
+	if x.Url == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Url)
+	return offset
+}
+
+func (x *FeedRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *FeedRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.LatestTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.LatestTime)
+	return offset
+}
+
+func (x *FeedRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
+	return offset
+}
+
+func (x *FeedRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.FeedMode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.FeedMode)
+	return offset
+}
+
+func (x *FeedRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.FeedType == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.FeedType)
+	return offset
+}
+
+func (x *FeedRequest) fastWriteField5(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.Cursor)
+	return offset
+}
+
+func (x *FeedResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *FeedResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *FeedResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *FeedResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoList == nil {
+		return offset
+	}
+	for i := range x.VideoList {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.VideoList[i])
+	}
+	return offset
+}
+
+func (x *FeedResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.NextTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.NextTime)
+	return offset
+}
+
+func (x *FeedResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.NextCursor)
+	return offset
+}
+
+func (x *PublishActionRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 2, x.Data)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Title == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Title)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Cover) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 4, x.Cover)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField5(buf []byte) (offset int) {
+	if x.Visibility == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.Visibility)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField6(buf []byte) (offset int) {
+	if !x.Draft {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.Draft)
+	return offset
+}
+
+func (x *PublishActionRequest) fastWriteField7(buf []byte) (offset int) {
+	if x.PublishAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.PublishAt)
+	return offset
+}
+
+func (x *PublishActionResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PublishActionResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *PublishActionResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *PublishActionResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.VideoId)
+	return offset
+}
+
+func (x *PublishListRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PublishListRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.UserId)
+	return offset
+}
+
+func (x *PublishListRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
+	return offset
+}
+
+func (x *PublishListRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Cursor)
+	return offset
+}
+
+func (x *PublishListResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *PublishListResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *PublishListResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *PublishListResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoList == nil {
+		return offset
+	}
+	for i := range x.VideoList {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.VideoList[i])
+	}
+	return offset
+}
+
+func (x *PublishListResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.NextCursor)
+	return offset
+}
+
+func (x *UploadInitRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Title == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Title)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.FileSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.FileSize)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.UploadId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.UploadId)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField5(buf []byte) (offset int) {
+	if x.Visibility == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.Visibility)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField6(buf []byte) (offset int) {
+	if !x.Draft {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.Draft)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField7(buf []byte) (offset int) {
+	if x.PublishAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.PublishAt)
+	return offset
+}
+
+func (x *UploadInitRequest) fastWriteField8(buf []byte) (offset int) {
+	if x.FileName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.FileName)
+	return offset
+}
+
+func (x *UploadInitResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.UploadId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.UploadId)
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.PartSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.PartSize)
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.PartCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.PartCount)
+	return offset
+}
+
+func (x *UploadInitResponse) fastWriteField6(buf []byte) (offset int) {
+	if len(x.UploadedParts) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 6, len(x.UploadedParts),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteInt32(buf[offset:], numTagOrKey, x.UploadedParts[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *UploadPartRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UploadPartRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UploadPartRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UploadId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.UploadId)
+	return offset
+}
+
+func (x *UploadPartRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.PartNumber == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.PartNumber)
+	return offset
+}
+
+func (x *UploadPartRequest) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 4, x.Data)
+	return offset
+}
+
+func (x *UploadPartResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UploadPartResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UploadPartResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UploadPartResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.PartNumber == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.PartNumber)
+	return offset
+}
+
+func (x *UploadCompleteRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UploadCompleteRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UploadCompleteRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UploadId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.UploadId)
+	return offset
+}
+
+func (x *UploadCompleteRequest) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Cover) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 3, x.Cover)
+	return offset
+}
+
+func (x *UploadCompleteResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UploadCompleteResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UploadCompleteResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UploadCompleteResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.VideoId)
+	return offset
+}
+
+func (x *UploadAbortRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UploadAbortRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UploadAbortRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UploadId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.UploadId)
+	return offset
+}
+
+func (x *UploadAbortResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UploadAbortResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UploadAbortResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *PublishStatusRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *PublishStatusRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *PublishStatusRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *PublishStatusResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.VideoId)
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.ProcessStatus == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.ProcessStatus)
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.Retries == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.Retries)
+	return offset
+}
+
+func (x *PublishStatusResponse) fastWriteField6(buf []byte) (offset int) {
+	if x.FailReason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.FailReason)
+	return offset
+}
+
+func (x *DraftListRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DraftListRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *DraftListRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Cursor)
+	return offset
+}
+
+func (x *DraftListResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *DraftListResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *DraftListResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *DraftListResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoList == nil {
+		return offset
+	}
+	for i := range x.VideoList {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.VideoList[i])
+	}
+	return offset
+}
+
+func (x *DraftListResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.NextCursor)
+	return offset
+}
+
+func (x *PublishDraftRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PublishDraftRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *PublishDraftRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *PublishDraftRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.PublishAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.PublishAt)
+	return offset
+}
+
+func (x *PublishDraftResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *PublishDraftResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *PublishDraftResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *DeleteVideoRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteVideoRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *DeleteVideoRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *DeleteVideoResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteVideoResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *DeleteVideoResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *UpdateVideoRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UpdateVideoRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *UpdateVideoRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *UpdateVideoRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Title == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Title)
+	return offset
+}
+
+func (x *UpdateVideoRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.Visibility == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.Visibility)
+	return offset
+}
+
+func (x *UpdateVideoResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateVideoResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *UpdateVideoResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *PlayActionRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PlayActionRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *PlayActionRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *PlayActionRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.WatchDuration == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 3, x.WatchDuration)
+	return offset
+}
+
+func (x *PlayActionResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *PlayActionResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *PlayActionResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *Tag) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *Tag) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.Id)
+	return offset
+}
+
+func (x *Tag) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Name)
+	return offset
+}
+
+func (x *Tag) fastWriteField3(buf []byte) (offset int) {
+	if x.VideoCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.VideoCount)
+	return offset
+}
+
+func (x *Tag) fastWriteField4(buf []byte) (offset int) {
+	if x.Score == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 4, x.Score)
+	return offset
+}
+
+func (x *TopicFeedRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *TopicFeedRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *TopicFeedRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Tag == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Tag)
+	return offset
+}
+
+func (x *TopicFeedRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Cursor)
+	return offset
+}
+
+func (x *TopicFeedResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *TopicFeedResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *TopicFeedResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *TopicFeedResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Tag == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.Tag)
+	return offset
+}
+
+func (x *TopicFeedResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.VideoList == nil {
+		return offset
+	}
+	for i := range x.VideoList {
+		offset += fastpb.WriteMessage(buf[offset:], 4, x.VideoList[i])
+	}
+	return offset
+}
+
+func (x *TopicFeedResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.NextCursor)
+	return offset
+}
+
+func (x *TrendingTagsRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *TrendingTagsRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Limit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.Limit)
+	return offset
+}
+
+func (x *TrendingTagsResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *TrendingTagsResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *TrendingTagsResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *TrendingTagsResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Tags == nil {
+		return offset
+	}
+	for i := range x.Tags {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.Tags[i])
+	}
+	return offset
+}
+
+func (x *ShareVideoRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ShareVideoRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
+	return offset
+}
+
+func (x *ShareVideoRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.VideoId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.VideoId)
+	return offset
+}
+
+func (x *ShareVideoRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Channel == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.Channel)
+	return offset
+}
+
+func (x *ShareVideoResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *ShareVideoResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *ShareVideoResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *ShareVideoResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.ShareCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.ShareCode)
+	return offset
+}
+
+func (x *ShareVideoResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.ShareUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.ShareUrl)
+	return offset
+}
+
+func (x *ShareVideoResponse) fastWriteField5(buf []byte) (offset int) {
+	if x.ShareCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.ShareCount)
+	return offset
+}
+
+func (x *ResolveShareRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ResolveShareRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.ShareCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.ShareCode)
+	return offset
+}
+
+func (x *ResolveShareRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
+	return offset
+}
+
+func (x *ResolveShareResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ResolveShareResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.StatusCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
+	return offset
+}
+
+func (x *ResolveShareResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.StatusMsg == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
+	return offset
+}
+
+func (x *ResolveShareResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Video == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.Video)
+	return offset
+}
+
+func (x *ResolveShareResponse) fastWriteField4(buf []byte) (offset int) {
+	if x.SharerId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.SharerId)
+	return offset
+}
+
+func (x *Video) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	n += x.sizeField16()
+	n += x.sizeField17()
+	n += x.sizeField18()
+	return n
+}
+
+func (x *Video) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.Id)
+	return n
+}
+
+func (x *Video) sizeField2() (n int) {
+	if x.Author == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.Author)
+	return n
+}
+
+func (x *Video) sizeField3() (n int) {
+	if x.PlayUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.PlayUrl)
+	return n
+}
+
+func (x *Video) sizeField4() (n int) {
+	if x.CoverUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.CoverUrl)
+	return n
+}
+
+func (x *Video) sizeField5() (n int) {
+	if x.FavoriteCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.FavoriteCount)
+	return n
+}
+
+func (x *Video) sizeField6() (n int) {
+	if x.CommentCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.CommentCount)
+	return n
+}
+
+func (x *Video) sizeField7() (n int) {
+	if !x.IsFavorite {
+		return n
+	}
+	n += fastpb.SizeBool(7, x.IsFavorite)
+	return n
+}
+
+func (x *Video) sizeField8() (n int) {
+	if x.Title == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.Title)
+	return n
+}
+
+func (x *Video) sizeField9() (n int) {
+	if x.ShareCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(9, x.ShareCount)
+	return n
+}
+
+func (x *Video) sizeField10() (n int) {
+	if x.Duration == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(10, x.Duration)
+	return n
+}
+
+func (x *Video) sizeField11() (n int) {
+	if x.Width == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(11, x.Width)
+	return n
+}
+
+func (x *Video) sizeField12() (n int) {
+	if x.Height == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(12, x.Height)
+	return n
+}
+
+func (x *Video) sizeField13() (n int) {
+	if x.Codec == "" {
+		return n
+	}
+	n += fastpb.SizeString(13, x.Codec)
+	return n
+}
+
+func (x *Video) sizeField14() (n int) {
+	if x.CoverThumbnails == nil {
+		return n
+	}
+	for i := range x.CoverThumbnails {
+		n += fastpb.SizeMessage(14, x.CoverThumbnails[i])
+	}
+	return n
+}
+
+func (x *Video) sizeField15() (n int) {
+	if x.PlayCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(15, x.PlayCount)
+	return n
+}
+
+func (x *Video) sizeField16() (n int) {
+	if x.Visibility == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(16, x.Visibility)
+	return n
+}
+
+func (x *Video) sizeField17() (n int) {
+	if x.PublishStatus == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(17, x.PublishStatus)
+	return n
+}
+
+func (x *Video) sizeField18() (n int) {
+	if x.PublishAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(18, x.PublishAt)
+	return n
+}
+
+func (x *CoverThumbnail) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CoverThumbnail) sizeField1() (n int) {
+	if x.Width == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.Width)
+	return n
+}
+
+func (x *CoverThumbnail) sizeField2() (n int) {
+	if x.Url == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Url)
+	return n
+}
+
+func (x *FeedRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *FeedRequest) sizeField1() (n int) {
+	if x.LatestTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.LatestTime)
+	return n
+}
+
+func (x *FeedRequest) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Token)
+	return n
+}
+
+func (x *FeedRequest) sizeField3() (n int) {
+	if x.FeedMode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.FeedMode)
+	return n
+}
+
+func (x *FeedRequest) sizeField4() (n int) {
+	if x.FeedType == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.FeedType)
+	return n
+}
+
+func (x *FeedRequest) sizeField5() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.Cursor)
+	return n
+}
+
+func (x *FeedResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *FeedResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *FeedResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *FeedResponse) sizeField3() (n int) {
+	if x.VideoList == nil {
+		return n
+	}
+	for i := range x.VideoList {
+		n += fastpb.SizeMessage(3, x.VideoList[i])
+	}
+	return n
+}
+
+func (x *FeedResponse) sizeField4() (n int) {
+	if x.NextTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.NextTime)
+	return n
+}
+
+func (x *FeedResponse) sizeField5() (n int) {
+	if x.NextCursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.NextCursor)
+	return n
+}
+
+func (x *PublishActionRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *PublishActionRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField2() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(2, x.Data)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField3() (n int) {
+	if x.Title == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.Title)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField4() (n int) {
+	if len(x.Cover) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(4, x.Cover)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField5() (n int) {
+	if x.Visibility == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.Visibility)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField6() (n int) {
+	if !x.Draft {
+		return n
+	}
+	n += fastpb.SizeBool(6, x.Draft)
+	return n
+}
+
+func (x *PublishActionRequest) sizeField7() (n int) {
+	if x.PublishAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.PublishAt)
+	return n
+}
+
+func (x *PublishActionResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PublishActionResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *PublishActionResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *PublishActionResponse) sizeField3() (n int) {
+	if x.VideoId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.VideoId)
+	return n
+}
+
+func (x *PublishListRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PublishListRequest) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.UserId)
+	return n
+}
+
+func (x *PublishListRequest) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Token)
+	return n
+}
+
+func (x *PublishListRequest) sizeField3() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.Cursor)
+	return n
+}
+
+func (x *PublishListResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *PublishListResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *PublishListResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *PublishListResponse) sizeField3() (n int) {
+	if x.VideoList == nil {
+		return n
+	}
+	for i := range x.VideoList {
+		n += fastpb.SizeMessage(3, x.VideoList[i])
+	}
+	return n
+}
+
+func (x *PublishListResponse) sizeField4() (n int) {
+	if x.NextCursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.NextCursor)
+	return n
+}
+
+func (x *UploadInitRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *UploadInitRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField2() (n int) {
+	if x.Title == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Title)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField3() (n int) {
+	if x.FileSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.FileSize)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField4() (n int) {
+	if x.UploadId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.UploadId)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField5() (n int) {
+	if x.Visibility == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.Visibility)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField6() (n int) {
+	if !x.Draft {
+		return n
+	}
+	n += fastpb.SizeBool(6, x.Draft)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField7() (n int) {
+	if x.PublishAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.PublishAt)
+	return n
+}
+
+func (x *UploadInitRequest) sizeField8() (n int) {
+	if x.FileName == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.FileName)
+	return n
+}
+
+func (x *UploadInitResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *UploadInitResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UploadInitResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *UploadInitResponse) sizeField3() (n int) {
+	if x.UploadId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.UploadId)
+	return n
+}
+
+func (x *UploadInitResponse) sizeField4() (n int) {
+	if x.PartSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.PartSize)
+	return n
+}
+
+func (x *UploadInitResponse) sizeField5() (n int) {
+	if x.PartCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.PartCount)
+	return n
+}
+
+func (x *UploadInitResponse) sizeField6() (n int) {
+	if len(x.UploadedParts) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(6, len(x.UploadedParts),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeInt32(numTagOrKey, x.UploadedParts[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *UploadPartRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *UploadPartRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *Video) fastWriteField4(buf []byte) (offset int) {
-	if x.CoverUrl == "" {
-		return offset
+func (x *UploadPartRequest) sizeField2() (n int) {
+	if x.UploadId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.UploadId)
+	return n
+}
+
+func (x *UploadPartRequest) sizeField3() (n int) {
+	if x.PartNumber == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.PartNumber)
+	return n
+}
+
+func (x *UploadPartRequest) sizeField4() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(4, x.Data)
+	return n
+}
+
+func (x *UploadPartResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UploadPartResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UploadPartResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *UploadPartResponse) sizeField3() (n int) {
+	if x.PartNumber == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.PartNumber)
+	return n
+}
+
+func (x *UploadCompleteRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UploadCompleteRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *UploadCompleteRequest) sizeField2() (n int) {
+	if x.UploadId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.UploadId)
+	return n
+}
+
+func (x *UploadCompleteRequest) sizeField3() (n int) {
+	if len(x.Cover) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(3, x.Cover)
+	return n
+}
+
+func (x *UploadCompleteResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UploadCompleteResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UploadCompleteResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *UploadCompleteResponse) sizeField3() (n int) {
+	if x.VideoId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.VideoId)
+	return n
+}
+
+func (x *UploadAbortRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UploadAbortRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *UploadAbortRequest) sizeField2() (n int) {
+	if x.UploadId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.UploadId)
+	return n
+}
+
+func (x *UploadAbortResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UploadAbortResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *UploadAbortResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *PublishStatusRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *PublishStatusRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *PublishStatusRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.VideoId)
+	return n
+}
+
+func (x *PublishStatusResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField3() (n int) {
+	if x.VideoId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.VideoId)
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField4() (n int) {
+	if x.ProcessStatus == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.ProcessStatus)
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField5() (n int) {
+	if x.Retries == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.Retries)
+	return n
+}
+
+func (x *PublishStatusResponse) sizeField6() (n int) {
+	if x.FailReason == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.FailReason)
+	return n
+}
+
+func (x *DraftListRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DraftListRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.Token)
+	return n
+}
+
+func (x *DraftListRequest) sizeField2() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.Cursor)
+	return n
+}
+
+func (x *DraftListResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *DraftListResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *DraftListResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *DraftListResponse) sizeField3() (n int) {
+	if x.VideoList == nil {
+		return n
+	}
+	for i := range x.VideoList {
+		n += fastpb.SizeMessage(3, x.VideoList[i])
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.CoverUrl)
-	return offset
+	return n
 }
 
-func (x *Video) fastWriteField5(buf []byte) (offset int) {
-	if x.FavoriteCount == 0 {
-		return offset
+func (x *DraftListResponse) sizeField4() (n int) {
+	if x.NextCursor == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 5, x.FavoriteCount)
-	return offset
+	n += fastpb.SizeString(4, x.NextCursor)
+	return n
 }
 
-func (x *Video) fastWriteField6(buf []byte) (offset int) {
-	if x.CommentCount == 0 {
-		return offset
+func (x *PublishDraftRequest) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 6, x.CommentCount)
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *Video) fastWriteField7(buf []byte) (offset int) {
-	if !x.IsFavorite {
-		return offset
+func (x *PublishDraftRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteBool(buf[offset:], 7, x.IsFavorite)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *Video) fastWriteField8(buf []byte) (offset int) {
-	if x.Title == "" {
-		return offset
+func (x *PublishDraftRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 8, x.Title)
-	return offset
+	n += fastpb.SizeInt64(2, x.VideoId)
+	return n
 }
 
-func (x *Video) fastWriteField9(buf []byte) (offset int) {
-	if x.ShareCount == 0 {
-		return offset
+func (x *PublishDraftRequest) sizeField3() (n int) {
+	if x.PublishAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 9, x.ShareCount)
-	return offset
+	n += fastpb.SizeInt64(3, x.PublishAt)
+	return n
 }
 
-func (x *FeedRequest) FastWrite(buf []byte) (offset int) {
+func (x *PublishDraftResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *FeedRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.LatestTime == 0 {
-		return offset
+func (x *PublishDraftResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.LatestTime)
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *FeedRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *PublishDraftResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *FeedResponse) FastWrite(buf []byte) (offset int) {
+func (x *DeleteVideoRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *FeedResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.StatusCode == 0 {
-		return offset
+func (x *DeleteVideoRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *FeedResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.StatusMsg == "" {
-		return offset
+func (x *DeleteVideoRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
+	n += fastpb.SizeInt64(2, x.VideoId)
+	return n
 }
 
-func (x *FeedResponse) fastWriteField3(buf []byte) (offset int) {
-	if x.VideoList == nil {
-		return offset
+func (x *DeleteVideoResponse) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	for i := range x.VideoList {
-		offset += fastpb.WriteMessage(buf[offset:], 3, x.VideoList[i])
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeleteVideoResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
 	}
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *FeedResponse) fastWriteField4(buf []byte) (offset int) {
-	if x.NextTime == 0 {
-		return offset
+func (x *DeleteVideoResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 4, x.NextTime)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *PublishActionRequest) FastWrite(buf []byte) (offset int) {
+func (x *UpdateVideoRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
 }
 
-func (x *PublishActionRequest) fastWriteField1(buf []byte) (offset int) {
+func (x *UpdateVideoRequest) sizeField1() (n int) {
 	if x.Token == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.Token)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *PublishActionRequest) fastWriteField2(buf []byte) (offset int) {
-	if len(x.Data) == 0 {
-		return offset
+func (x *UpdateVideoRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
+		return n
 	}
-	offset += fastpb.WriteBytes(buf[offset:], 2, x.Data)
-	return offset
+	n += fastpb.SizeInt64(2, x.VideoId)
+	return n
 }
 
-func (x *PublishActionRequest) fastWriteField3(buf []byte) (offset int) {
+func (x *UpdateVideoRequest) sizeField3() (n int) {
 	if x.Title == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.Title)
-	return offset
+	n += fastpb.SizeString(3, x.Title)
+	return n
 }
 
-func (x *PublishActionResponse) FastWrite(buf []byte) (offset int) {
+func (x *UpdateVideoRequest) sizeField4() (n int) {
+	if x.Visibility == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.Visibility)
+	return n
+}
+
+func (x *UpdateVideoResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *PublishActionResponse) fastWriteField1(buf []byte) (offset int) {
+func (x *UpdateVideoResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
 }
 
-func (x *PublishActionResponse) fastWriteField2(buf []byte) (offset int) {
+func (x *UpdateVideoResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
 }
 
-func (x *PublishListRequest) FastWrite(buf []byte) (offset int) {
+func (x *PlayActionRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *PublishListRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *PlayActionRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.UserId)
-	return offset
+	n += fastpb.SizeString(1, x.Token)
+	return n
 }
 
-func (x *PublishListRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *PlayActionRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.Token)
-	return offset
+	n += fastpb.SizeInt64(2, x.VideoId)
+	return n
 }
 
-func (x *PublishListResponse) FastWrite(buf []byte) (offset int) {
+func (x *PlayActionRequest) sizeField3() (n int) {
+	if x.WatchDuration == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(3, x.WatchDuration)
+	return n
+}
+
+func (x *PlayActionResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *PlayActionResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.StatusCode)
+	return n
+}
+
+func (x *PlayActionResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.StatusMsg)
+	return n
+}
+
+func (x *Tag) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *Tag) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.Id)
+	return n
 }
 
-func (x *PublishListResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.StatusCode == 0 {
-		return offset
+func (x *Tag) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.StatusCode)
-	return offset
+	n += fastpb.SizeString(2, x.Name)
+	return n
 }
 
-func (x *PublishListResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.StatusMsg == "" {
-		return offset
+func (x *Tag) sizeField3() (n int) {
+	if x.VideoCount == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.StatusMsg)
-	return offset
+	n += fastpb.SizeInt64(3, x.VideoCount)
+	return n
 }
 
-func (x *PublishListResponse) fastWriteField3(buf []byte) (offset int) {
-	if x.VideoList == nil {
-		return offset
-	}
-	for i := range x.VideoList {
-		offset += fastpb.WriteMessage(buf[offset:], 3, x.VideoList[i])
+func (x *Tag) sizeField4() (n int) {
+	if x.Score == 0 {
+		return n
 	}
-	return offset
+	n += fastpb.SizeDouble(4, x.Score)
+	return n
 }
 
-func (x *Video) Size() (n int) {
+func (x *TopicFeedRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
-	n += x.sizeField6()
-	n += x.sizeField7()
-	n += x.sizeField8()
-	n += x.sizeField9()
 	return n
 }
 
-func (x *Video) sizeField1() (n int) {
-	if x.Id == 0 {
+func (x *TopicFeedRequest) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.Id)
+	n += fastpb.SizeString(1, x.Token)
 	return n
 }
 
-func (x *Video) sizeField2() (n int) {
-	if x.Author == nil {
+func (x *TopicFeedRequest) sizeField2() (n int) {
+	if x.Tag == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(2, x.Author)
+	n += fastpb.SizeString(2, x.Tag)
 	return n
 }
 
-func (x *Video) sizeField3() (n int) {
-	if x.PlayUrl == "" {
+func (x *TopicFeedRequest) sizeField3() (n int) {
+	if x.Cursor == "" {
 		return n
 	}
-	n += fastpb.SizeString(3, x.PlayUrl)
+	n += fastpb.SizeString(3, x.Cursor)
 	return n
 }
 
-func (x *Video) sizeField4() (n int) {
-	if x.CoverUrl == "" {
+func (x *TopicFeedResponse) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(4, x.CoverUrl)
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
 	return n
 }
 
-func (x *Video) sizeField5() (n int) {
-	if x.FavoriteCount == 0 {
+func (x *TopicFeedResponse) sizeField1() (n int) {
+	if x.StatusCode == 0 {
 		return n
 	}
-	n += fastpb.SizeInt64(5, x.FavoriteCount)
+	n += fastpb.SizeInt32(1, x.StatusCode)
 	return n
 }
 
-func (x *Video) sizeField6() (n int) {
-	if x.CommentCount == 0 {
+func (x *TopicFeedResponse) sizeField2() (n int) {
+	if x.StatusMsg == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(6, x.CommentCount)
+	n += fastpb.SizeString(2, x.StatusMsg)
 	return n
 }
 
-func (x *Video) sizeField7() (n int) {
-	if !x.IsFavorite {
+func (x *TopicFeedResponse) sizeField3() (n int) {
+	if x.Tag == nil {
 		return n
 	}
-	n += fastpb.SizeBool(7, x.IsFavorite)
+	n += fastpb.SizeMessage(3, x.Tag)
 	return n
 }
 
-func (x *Video) sizeField8() (n int) {
-	if x.Title == "" {
+func (x *TopicFeedResponse) sizeField4() (n int) {
+	if x.VideoList == nil {
 		return n
 	}
-	n += fastpb.SizeString(8, x.Title)
+	for i := range x.VideoList {
+		n += fastpb.SizeMessage(4, x.VideoList[i])
+	}
 	return n
 }
 
-func (x *Video) sizeField9() (n int) {
-	if x.ShareCount == 0 {
+func (x *TopicFeedResponse) sizeField5() (n int) {
+	if x.NextCursor == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(9, x.ShareCount)
+	n += fastpb.SizeString(5, x.NextCursor)
 	return n
 }
 
-func (x *FeedRequest) Size() (n int) {
+func (x *TrendingTagsRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
-	n += x.sizeField2()
-	return n
-}
-
-func (x *FeedRequest) sizeField1() (n int) {
-	if x.LatestTime == 0 {
-		return n
-	}
-	n += fastpb.SizeInt64(1, x.LatestTime)
 	return n
 }
 
-func (x *FeedRequest) sizeField2() (n int) {
-	if x.Token == "" {
+func (x *TrendingTagsRequest) sizeField1() (n int) {
+	if x.Limit == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.Token)
+	n += fastpb.SizeInt32(1, x.Limit)
 	return n
 }
 
-func (x *FeedResponse) Size() (n int) {
+func (x *TrendingTagsResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *FeedResponse) sizeField1() (n int) {
+func (x *TrendingTagsResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
 		return n
 	}
@@ -793,7 +4978,7 @@ func (x *FeedResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *FeedResponse) sizeField2() (n int) {
+func (x *TrendingTagsResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
 		return n
 	}
@@ -801,25 +4986,17 @@ func (x *FeedResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *FeedResponse) sizeField3() (n int) {
-	if x.VideoList == nil {
+func (x *TrendingTagsResponse) sizeField3() (n int) {
+	if x.Tags == nil {
 		return n
 	}
-	for i := range x.VideoList {
-		n += fastpb.SizeMessage(3, x.VideoList[i])
-	}
-	return n
-}
-
-func (x *FeedResponse) sizeField4() (n int) {
-	if x.NextTime == 0 {
-		return n
+	for i := range x.Tags {
+		n += fastpb.SizeMessage(3, x.Tags[i])
 	}
-	n += fastpb.SizeInt64(4, x.NextTime)
 	return n
 }
 
-func (x *PublishActionRequest) Size() (n int) {
+func (x *ShareVideoRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -829,7 +5006,7 @@ func (x *PublishActionRequest) Size() (n int) {
 	return n
 }
 
-func (x *PublishActionRequest) sizeField1() (n int) {
+func (x *ShareVideoRequest) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -837,32 +5014,35 @@ func (x *PublishActionRequest) sizeField1() (n int) {
 	return n
 }
 
-func (x *PublishActionRequest) sizeField2() (n int) {
-	if len(x.Data) == 0 {
+func (x *ShareVideoRequest) sizeField2() (n int) {
+	if x.VideoId == 0 {
 		return n
 	}
-	n += fastpb.SizeBytes(2, x.Data)
+	n += fastpb.SizeInt64(2, x.VideoId)
 	return n
 }
 
-func (x *PublishActionRequest) sizeField3() (n int) {
-	if x.Title == "" {
+func (x *ShareVideoRequest) sizeField3() (n int) {
+	if x.Channel == "" {
 		return n
 	}
-	n += fastpb.SizeString(3, x.Title)
+	n += fastpb.SizeString(3, x.Channel)
 	return n
 }
 
-func (x *PublishActionResponse) Size() (n int) {
+func (x *ShareVideoResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
 	return n
 }
 
-func (x *PublishActionResponse) sizeField1() (n int) {
+func (x *ShareVideoResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
 		return n
 	}
@@ -870,7 +5050,7 @@ func (x *PublishActionResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *PublishActionResponse) sizeField2() (n int) {
+func (x *ShareVideoResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
 		return n
 	}
@@ -878,7 +5058,31 @@ func (x *PublishActionResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *PublishListRequest) Size() (n int) {
+func (x *ShareVideoResponse) sizeField3() (n int) {
+	if x.ShareCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.ShareCode)
+	return n
+}
+
+func (x *ShareVideoResponse) sizeField4() (n int) {
+	if x.ShareUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.ShareUrl)
+	return n
+}
+
+func (x *ShareVideoResponse) sizeField5() (n int) {
+	if x.ShareCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.ShareCount)
+	return n
+}
+
+func (x *ResolveShareRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -887,15 +5091,15 @@ func (x *PublishListRequest) Size() (n int) {
 	return n
 }
 
-func (x *PublishListRequest) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *ResolveShareRequest) sizeField1() (n int) {
+	if x.ShareCode == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.UserId)
+	n += fastpb.SizeString(1, x.ShareCode)
 	return n
 }
 
-func (x *PublishListRequest) sizeField2() (n int) {
+func (x *ResolveShareRequest) sizeField2() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -903,17 +5107,18 @@ func (x *PublishListRequest) sizeField2() (n int) {
 	return n
 }
 
-func (x *PublishListResponse) Size() (n int) {
+func (x *ResolveShareResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *PublishListResponse) sizeField1() (n int) {
+func (x *ResolveShareResponse) sizeField1() (n int) {
 	if x.StatusCode == 0 {
 		return n
 	}
@@ -921,7 +5126,7 @@ func (x *PublishListResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *PublishListResponse) sizeField2() (n int) {
+func (x *ResolveShareResponse) sizeField2() (n int) {
 	if x.StatusMsg == "" {
 		return n
 	}
@@ -929,31 +5134,54 @@ func (x *PublishListResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *PublishListResponse) sizeField3() (n int) {
-	if x.VideoList == nil {
+func (x *ResolveShareResponse) sizeField3() (n int) {
+	if x.Video == nil {
 		return n
 	}
-	for i := range x.VideoList {
-		n += fastpb.SizeMessage(3, x.VideoList[i])
+	n += fastpb.SizeMessage(3, x.Video)
+	return n
+}
+
+func (x *ResolveShareResponse) sizeField4() (n int) {
+	if x.SharerId == 0 {
+		return n
 	}
+	n += fastpb.SizeInt64(4, x.SharerId)
 	return n
 }
 
 var fieldIDToName_Video = map[int32]string{
-	1: "Id",
-	2: "Author",
-	3: "PlayUrl",
-	4: "CoverUrl",
-	5: "FavoriteCount",
-	6: "CommentCount",
-	7: "IsFavorite",
-	8: "Title",
-	9: "ShareCount",
+	1:  "Id",
+	2:  "Author",
+	3:  "PlayUrl",
+	4:  "CoverUrl",
+	5:  "FavoriteCount",
+	6:  "CommentCount",
+	7:  "IsFavorite",
+	8:  "Title",
+	9:  "ShareCount",
+	10: "Duration",
+	11: "Width",
+	12: "Height",
+	13: "Codec",
+	14: "CoverThumbnails",
+	15: "PlayCount",
+	16: "Visibility",
+	17: "PublishStatus",
+	18: "PublishAt",
+}
+
+var fieldIDToName_CoverThumbnail = map[int32]string{
+	1: "Width",
+	2: "Url",
 }
 
 var fieldIDToName_FeedRequest = map[int32]string{
 	1: "LatestTime",
 	2: "Token",
+	3: "FeedMode",
+	4: "FeedType",
+	5: "Cursor",
 }
 
 var fieldIDToName_FeedResponse = map[int32]string{
@@ -961,28 +5189,218 @@ var fieldIDToName_FeedResponse = map[int32]string{
 	2: "StatusMsg",
 	3: "VideoList",
 	4: "NextTime",
+	5: "NextCursor",
 }
 
 var fieldIDToName_PublishActionRequest = map[int32]string{
 	1: "Token",
 	2: "Data",
 	3: "Title",
+	4: "Cover",
+	5: "Visibility",
+	6: "Draft",
+	7: "PublishAt",
 }
 
 var fieldIDToName_PublishActionResponse = map[int32]string{
 	1: "StatusCode",
 	2: "StatusMsg",
+	3: "VideoId",
 }
 
 var fieldIDToName_PublishListRequest = map[int32]string{
 	1: "UserId",
 	2: "Token",
+	3: "Cursor",
 }
 
 var fieldIDToName_PublishListResponse = map[int32]string{
 	1: "StatusCode",
 	2: "StatusMsg",
 	3: "VideoList",
+	4: "NextCursor",
+}
+
+var fieldIDToName_UploadInitRequest = map[int32]string{
+	1: "Token",
+	2: "Title",
+	3: "FileSize",
+	4: "UploadId",
+	5: "Visibility",
+	6: "Draft",
+	7: "PublishAt",
+	8: "FileName",
+}
+
+var fieldIDToName_UploadInitResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "UploadId",
+	4: "PartSize",
+	5: "PartCount",
+	6: "UploadedParts",
+}
+
+var fieldIDToName_UploadPartRequest = map[int32]string{
+	1: "Token",
+	2: "UploadId",
+	3: "PartNumber",
+	4: "Data",
+}
+
+var fieldIDToName_UploadPartResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "PartNumber",
+}
+
+var fieldIDToName_UploadCompleteRequest = map[int32]string{
+	1: "Token",
+	2: "UploadId",
+	3: "Cover",
+}
+
+var fieldIDToName_UploadCompleteResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "VideoId",
+}
+
+var fieldIDToName_UploadAbortRequest = map[int32]string{
+	1: "Token",
+	2: "UploadId",
+}
+
+var fieldIDToName_UploadAbortResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_PublishStatusRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+}
+
+var fieldIDToName_PublishStatusResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "VideoId",
+	4: "ProcessStatus",
+	5: "Retries",
+	6: "FailReason",
+}
+
+var fieldIDToName_DraftListRequest = map[int32]string{
+	1: "Token",
+	2: "Cursor",
+}
+
+var fieldIDToName_DraftListResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "VideoList",
+	4: "NextCursor",
+}
+
+var fieldIDToName_PublishDraftRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+	3: "PublishAt",
+}
+
+var fieldIDToName_PublishDraftResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_DeleteVideoRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+}
+
+var fieldIDToName_DeleteVideoResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_UpdateVideoRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+	3: "Title",
+	4: "Visibility",
+}
+
+var fieldIDToName_UpdateVideoResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_PlayActionRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+	3: "WatchDuration",
+}
+
+var fieldIDToName_PlayActionResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+}
+
+var fieldIDToName_Tag = map[int32]string{
+	1: "Id",
+	2: "Name",
+	3: "VideoCount",
+	4: "Score",
+}
+
+var fieldIDToName_TopicFeedRequest = map[int32]string{
+	1: "Token",
+	2: "Tag",
+	3: "Cursor",
+}
+
+var fieldIDToName_TopicFeedResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Tag",
+	4: "VideoList",
+	5: "NextCursor",
+}
+
+var fieldIDToName_TrendingTagsRequest = map[int32]string{
+	1: "Limit",
+}
+
+var fieldIDToName_TrendingTagsResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Tags",
+}
+
+var fieldIDToName_ShareVideoRequest = map[int32]string{
+	1: "Token",
+	2: "VideoId",
+	3: "Channel",
+}
+
+var fieldIDToName_ShareVideoResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "ShareCode",
+	4: "ShareUrl",
+	5: "ShareCount",
+}
+
+var fieldIDToName_ResolveShareRequest = map[int32]string{
+	1: "ShareCode",
+	2: "Token",
+}
+
+var fieldIDToName_ResolveShareResponse = map[int32]string{
+	1: "StatusCode",
+	2: "StatusMsg",
+	3: "Video",
+	4: "SharerId",
 }
 
 var _ = user.File_user_proto
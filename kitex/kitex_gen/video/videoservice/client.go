@@ -14,6 +14,20 @@ type Client interface {
 	Feed(ctx context.Context, Req *video.FeedRequest, callOptions ...callopt.Option) (r *video.FeedResponse, err error)
 	PublishAction(ctx context.Context, Req *video.PublishActionRequest, callOptions ...callopt.Option) (r *video.PublishActionResponse, err error)
 	PublishList(ctx context.Context, Req *video.PublishListRequest, callOptions ...callopt.Option) (r *video.PublishListResponse, err error)
+	UploadInit(ctx context.Context, Req *video.UploadInitRequest, callOptions ...callopt.Option) (r *video.UploadInitResponse, err error)
+	UploadPart(ctx context.Context, Req *video.UploadPartRequest, callOptions ...callopt.Option) (r *video.UploadPartResponse, err error)
+	UploadComplete(ctx context.Context, Req *video.UploadCompleteRequest, callOptions ...callopt.Option) (r *video.UploadCompleteResponse, err error)
+	UploadAbort(ctx context.Context, Req *video.UploadAbortRequest, callOptions ...callopt.Option) (r *video.UploadAbortResponse, err error)
+	PublishStatus(ctx context.Context, Req *video.PublishStatusRequest, callOptions ...callopt.Option) (r *video.PublishStatusResponse, err error)
+	DeleteVideo(ctx context.Context, Req *video.DeleteVideoRequest, callOptions ...callopt.Option) (r *video.DeleteVideoResponse, err error)
+	UpdateVideo(ctx context.Context, Req *video.UpdateVideoRequest, callOptions ...callopt.Option) (r *video.UpdateVideoResponse, err error)
+	PlayAction(ctx context.Context, Req *video.PlayActionRequest, callOptions ...callopt.Option) (r *video.PlayActionResponse, err error)
+	TopicFeed(ctx context.Context, Req *video.TopicFeedRequest, callOptions ...callopt.Option) (r *video.TopicFeedResponse, err error)
+	TrendingTags(ctx context.Context, Req *video.TrendingTagsRequest, callOptions ...callopt.Option) (r *video.TrendingTagsResponse, err error)
+	ShareVideo(ctx context.Context, Req *video.ShareVideoRequest, callOptions ...callopt.Option) (r *video.ShareVideoResponse, err error)
+	ResolveShare(ctx context.Context, Req *video.ResolveShareRequest, callOptions ...callopt.Option) (r *video.ResolveShareResponse, err error)
+	DraftList(ctx context.Context, Req *video.DraftListRequest, callOptions ...callopt.Option) (r *video.DraftListResponse, err error)
+	PublishDraft(ctx context.Context, Req *video.PublishDraftRequest, callOptions ...callopt.Option) (r *video.PublishDraftResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +73,73 @@ func (p *kVideoServiceClient) PublishList(ctx context.Context, Req *video.Publis
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.PublishList(ctx, Req)
 }
+
+func (p *kVideoServiceClient) UploadInit(ctx context.Context, Req *video.UploadInitRequest, callOptions ...callopt.Option) (r *video.UploadInitResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadInit(ctx, Req)
+}
+
+func (p *kVideoServiceClient) UploadPart(ctx context.Context, Req *video.UploadPartRequest, callOptions ...callopt.Option) (r *video.UploadPartResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadPart(ctx, Req)
+}
+
+func (p *kVideoServiceClient) UploadComplete(ctx context.Context, Req *video.UploadCompleteRequest, callOptions ...callopt.Option) (r *video.UploadCompleteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadComplete(ctx, Req)
+}
+
+func (p *kVideoServiceClient) UploadAbort(ctx context.Context, Req *video.UploadAbortRequest, callOptions ...callopt.Option) (r *video.UploadAbortResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadAbort(ctx, Req)
+}
+
+func (p *kVideoServiceClient) PublishStatus(ctx context.Context, Req *video.PublishStatusRequest, callOptions ...callopt.Option) (r *video.PublishStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PublishStatus(ctx, Req)
+}
+
+func (p *kVideoServiceClient) DeleteVideo(ctx context.Context, Req *video.DeleteVideoRequest, callOptions ...callopt.Option) (r *video.DeleteVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteVideo(ctx, Req)
+}
+
+func (p *kVideoServiceClient) UpdateVideo(ctx context.Context, Req *video.UpdateVideoRequest, callOptions ...callopt.Option) (r *video.UpdateVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateVideo(ctx, Req)
+}
+
+func (p *kVideoServiceClient) PlayAction(ctx context.Context, Req *video.PlayActionRequest, callOptions ...callopt.Option) (r *video.PlayActionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PlayAction(ctx, Req)
+}
+
+func (p *kVideoServiceClient) TopicFeed(ctx context.Context, Req *video.TopicFeedRequest, callOptions ...callopt.Option) (r *video.TopicFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TopicFeed(ctx, Req)
+}
+
+func (p *kVideoServiceClient) TrendingTags(ctx context.Context, Req *video.TrendingTagsRequest, callOptions ...callopt.Option) (r *video.TrendingTagsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TrendingTags(ctx, Req)
+}
+
+func (p *kVideoServiceClient) ShareVideo(ctx context.Context, Req *video.ShareVideoRequest, callOptions ...callopt.Option) (r *video.ShareVideoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ShareVideo(ctx, Req)
+}
+
+func (p *kVideoServiceClient) ResolveShare(ctx context.Context, Req *video.ResolveShareRequest, callOptions ...callopt.Option) (r *video.ResolveShareResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveShare(ctx, Req)
+}
+
+func (p *kVideoServiceClient) DraftList(ctx context.Context, Req *video.DraftListRequest, callOptions ...callopt.Option) (r *video.DraftListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DraftList(ctx, Req)
+}
+
+func (p *kVideoServiceClient) PublishDraft(ctx context.Context, Req *video.PublishDraftRequest, callOptions ...callopt.Option) (r *video.PublishDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PublishDraft(ctx, Req)
+}
@@ -22,9 +22,23 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "VideoService"
 	handlerType := (*video.VideoService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Feed":          kitex.NewMethodInfo(feedHandler, newFeedArgs, newFeedResult, false),
-		"PublishAction": kitex.NewMethodInfo(publishActionHandler, newPublishActionArgs, newPublishActionResult, false),
-		"PublishList":   kitex.NewMethodInfo(publishListHandler, newPublishListArgs, newPublishListResult, false),
+		"Feed":           kitex.NewMethodInfo(feedHandler, newFeedArgs, newFeedResult, false),
+		"PublishAction":  kitex.NewMethodInfo(publishActionHandler, newPublishActionArgs, newPublishActionResult, false),
+		"PublishList":    kitex.NewMethodInfo(publishListHandler, newPublishListArgs, newPublishListResult, false),
+		"UploadInit":     kitex.NewMethodInfo(uploadInitHandler, newUploadInitArgs, newUploadInitResult, false),
+		"UploadPart":     kitex.NewMethodInfo(uploadPartHandler, newUploadPartArgs, newUploadPartResult, false),
+		"UploadComplete": kitex.NewMethodInfo(uploadCompleteHandler, newUploadCompleteArgs, newUploadCompleteResult, false),
+		"UploadAbort":    kitex.NewMethodInfo(uploadAbortHandler, newUploadAbortArgs, newUploadAbortResult, false),
+		"PublishStatus":  kitex.NewMethodInfo(publishStatusHandler, newPublishStatusArgs, newPublishStatusResult, false),
+		"DeleteVideo":    kitex.NewMethodInfo(deleteVideoHandler, newDeleteVideoArgs, newDeleteVideoResult, false),
+		"UpdateVideo":    kitex.NewMethodInfo(updateVideoHandler, newUpdateVideoArgs, newUpdateVideoResult, false),
+		"PlayAction":     kitex.NewMethodInfo(playActionHandler, newPlayActionArgs, newPlayActionResult, false),
+		"TopicFeed":      kitex.NewMethodInfo(topicFeedHandler, newTopicFeedArgs, newTopicFeedResult, false),
+		"TrendingTags":   kitex.NewMethodInfo(trendingTagsHandler, newTrendingTagsArgs, newTrendingTagsResult, false),
+		"ShareVideo":     kitex.NewMethodInfo(shareVideoHandler, newShareVideoArgs, newShareVideoResult, false),
+		"ResolveShare":   kitex.NewMethodInfo(resolveShareHandler, newResolveShareArgs, newResolveShareResult, false),
+		"DraftList":      kitex.NewMethodInfo(draftListHandler, newDraftListArgs, newDraftListResult, false),
+		"PublishDraft":   kitex.NewMethodInfo(publishDraftHandler, newPublishDraftArgs, newPublishDraftResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "video",
@@ -475,6 +489,2036 @@ func (p *PublishListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
+func uploadInitHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.UploadInitRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).UploadInit(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UploadInitArgs:
+		success, err := handler.(video.VideoService).UploadInit(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadInitResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUploadInitArgs() interface{} {
+	return &UploadInitArgs{}
+}
+
+func newUploadInitResult() interface{} {
+	return &UploadInitResult{}
+}
+
+type UploadInitArgs struct {
+	Req *video.UploadInitRequest
+}
+
+func (p *UploadInitArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.UploadInitRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadInitArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadInitArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadInitArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UploadInitArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadInitArgs) Unmarshal(in []byte) error {
+	msg := new(video.UploadInitRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadInitArgs_Req_DEFAULT *video.UploadInitRequest
+
+func (p *UploadInitArgs) GetReq() *video.UploadInitRequest {
+	if !p.IsSetReq() {
+		return UploadInitArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadInitArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UploadInitResult struct {
+	Success *video.UploadInitResponse
+}
+
+var UploadInitResult_Success_DEFAULT *video.UploadInitResponse
+
+func (p *UploadInitResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.UploadInitResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadInitResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadInitResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadInitResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UploadInitResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadInitResult) Unmarshal(in []byte) error {
+	msg := new(video.UploadInitResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadInitResult) GetSuccess() *video.UploadInitResponse {
+	if !p.IsSetSuccess() {
+		return UploadInitResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadInitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.UploadInitResponse)
+}
+
+func (p *UploadInitResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func uploadPartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.UploadPartRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).UploadPart(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UploadPartArgs:
+		success, err := handler.(video.VideoService).UploadPart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadPartResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUploadPartArgs() interface{} {
+	return &UploadPartArgs{}
+}
+
+func newUploadPartResult() interface{} {
+	return &UploadPartResult{}
+}
+
+type UploadPartArgs struct {
+	Req *video.UploadPartRequest
+}
+
+func (p *UploadPartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.UploadPartRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadPartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadPartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadPartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UploadPartArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadPartArgs) Unmarshal(in []byte) error {
+	msg := new(video.UploadPartRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadPartArgs_Req_DEFAULT *video.UploadPartRequest
+
+func (p *UploadPartArgs) GetReq() *video.UploadPartRequest {
+	if !p.IsSetReq() {
+		return UploadPartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadPartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UploadPartResult struct {
+	Success *video.UploadPartResponse
+}
+
+var UploadPartResult_Success_DEFAULT *video.UploadPartResponse
+
+func (p *UploadPartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.UploadPartResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadPartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadPartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadPartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UploadPartResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadPartResult) Unmarshal(in []byte) error {
+	msg := new(video.UploadPartResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadPartResult) GetSuccess() *video.UploadPartResponse {
+	if !p.IsSetSuccess() {
+		return UploadPartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadPartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.UploadPartResponse)
+}
+
+func (p *UploadPartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func uploadCompleteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.UploadCompleteRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).UploadComplete(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UploadCompleteArgs:
+		success, err := handler.(video.VideoService).UploadComplete(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadCompleteResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUploadCompleteArgs() interface{} {
+	return &UploadCompleteArgs{}
+}
+
+func newUploadCompleteResult() interface{} {
+	return &UploadCompleteResult{}
+}
+
+type UploadCompleteArgs struct {
+	Req *video.UploadCompleteRequest
+}
+
+func (p *UploadCompleteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.UploadCompleteRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadCompleteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadCompleteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadCompleteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UploadCompleteArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadCompleteArgs) Unmarshal(in []byte) error {
+	msg := new(video.UploadCompleteRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadCompleteArgs_Req_DEFAULT *video.UploadCompleteRequest
+
+func (p *UploadCompleteArgs) GetReq() *video.UploadCompleteRequest {
+	if !p.IsSetReq() {
+		return UploadCompleteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadCompleteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UploadCompleteResult struct {
+	Success *video.UploadCompleteResponse
+}
+
+var UploadCompleteResult_Success_DEFAULT *video.UploadCompleteResponse
+
+func (p *UploadCompleteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.UploadCompleteResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadCompleteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadCompleteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadCompleteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UploadCompleteResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadCompleteResult) Unmarshal(in []byte) error {
+	msg := new(video.UploadCompleteResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadCompleteResult) GetSuccess() *video.UploadCompleteResponse {
+	if !p.IsSetSuccess() {
+		return UploadCompleteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadCompleteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.UploadCompleteResponse)
+}
+
+func (p *UploadCompleteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func uploadAbortHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.UploadAbortRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).UploadAbort(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UploadAbortArgs:
+		success, err := handler.(video.VideoService).UploadAbort(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadAbortResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUploadAbortArgs() interface{} {
+	return &UploadAbortArgs{}
+}
+
+func newUploadAbortResult() interface{} {
+	return &UploadAbortResult{}
+}
+
+type UploadAbortArgs struct {
+	Req *video.UploadAbortRequest
+}
+
+func (p *UploadAbortArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.UploadAbortRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadAbortArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadAbortArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadAbortArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UploadAbortArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadAbortArgs) Unmarshal(in []byte) error {
+	msg := new(video.UploadAbortRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadAbortArgs_Req_DEFAULT *video.UploadAbortRequest
+
+func (p *UploadAbortArgs) GetReq() *video.UploadAbortRequest {
+	if !p.IsSetReq() {
+		return UploadAbortArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadAbortArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UploadAbortResult struct {
+	Success *video.UploadAbortResponse
+}
+
+var UploadAbortResult_Success_DEFAULT *video.UploadAbortResponse
+
+func (p *UploadAbortResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.UploadAbortResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadAbortResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadAbortResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadAbortResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UploadAbortResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadAbortResult) Unmarshal(in []byte) error {
+	msg := new(video.UploadAbortResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadAbortResult) GetSuccess() *video.UploadAbortResponse {
+	if !p.IsSetSuccess() {
+		return UploadAbortResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadAbortResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.UploadAbortResponse)
+}
+
+func (p *UploadAbortResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func publishStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.PublishStatusRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).PublishStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *PublishStatusArgs:
+		success, err := handler.(video.VideoService).PublishStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*PublishStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newPublishStatusArgs() interface{} {
+	return &PublishStatusArgs{}
+}
+
+func newPublishStatusResult() interface{} {
+	return &PublishStatusResult{}
+}
+
+type PublishStatusArgs struct {
+	Req *video.PublishStatusRequest
+}
+
+func (p *PublishStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.PublishStatusRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *PublishStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *PublishStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *PublishStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in PublishStatusArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *PublishStatusArgs) Unmarshal(in []byte) error {
+	msg := new(video.PublishStatusRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var PublishStatusArgs_Req_DEFAULT *video.PublishStatusRequest
+
+func (p *PublishStatusArgs) GetReq() *video.PublishStatusRequest {
+	if !p.IsSetReq() {
+		return PublishStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *PublishStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type PublishStatusResult struct {
+	Success *video.PublishStatusResponse
+}
+
+var PublishStatusResult_Success_DEFAULT *video.PublishStatusResponse
+
+func (p *PublishStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.PublishStatusResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *PublishStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *PublishStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *PublishStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in PublishStatusResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *PublishStatusResult) Unmarshal(in []byte) error {
+	msg := new(video.PublishStatusResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *PublishStatusResult) GetSuccess() *video.PublishStatusResponse {
+	if !p.IsSetSuccess() {
+		return PublishStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *PublishStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.PublishStatusResponse)
+}
+
+func (p *PublishStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func deleteVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.DeleteVideoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).DeleteVideo(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DeleteVideoArgs:
+		success, err := handler.(video.VideoService).DeleteVideo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteVideoResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDeleteVideoArgs() interface{} {
+	return &DeleteVideoArgs{}
+}
+
+func newDeleteVideoResult() interface{} {
+	return &DeleteVideoResult{}
+}
+
+type DeleteVideoArgs struct {
+	Req *video.DeleteVideoRequest
+}
+
+func (p *DeleteVideoArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.DeleteVideoRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteVideoArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteVideoArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteVideoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in DeleteVideoArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteVideoArgs) Unmarshal(in []byte) error {
+	msg := new(video.DeleteVideoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteVideoArgs_Req_DEFAULT *video.DeleteVideoRequest
+
+func (p *DeleteVideoArgs) GetReq() *video.DeleteVideoRequest {
+	if !p.IsSetReq() {
+		return DeleteVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type DeleteVideoResult struct {
+	Success *video.DeleteVideoResponse
+}
+
+var DeleteVideoResult_Success_DEFAULT *video.DeleteVideoResponse
+
+func (p *DeleteVideoResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.DeleteVideoResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteVideoResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteVideoResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteVideoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in DeleteVideoResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteVideoResult) Unmarshal(in []byte) error {
+	msg := new(video.DeleteVideoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteVideoResult) GetSuccess() *video.DeleteVideoResponse {
+	if !p.IsSetSuccess() {
+		return DeleteVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.DeleteVideoResponse)
+}
+
+func (p *DeleteVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func updateVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.UpdateVideoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).UpdateVideo(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateVideoArgs:
+		success, err := handler.(video.VideoService).UpdateVideo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateVideoResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateVideoArgs() interface{} {
+	return &UpdateVideoArgs{}
+}
+
+func newUpdateVideoResult() interface{} {
+	return &UpdateVideoResult{}
+}
+
+type UpdateVideoArgs struct {
+	Req *video.UpdateVideoRequest
+}
+
+func (p *UpdateVideoArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.UpdateVideoRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateVideoArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateVideoArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateVideoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in UpdateVideoArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateVideoArgs) Unmarshal(in []byte) error {
+	msg := new(video.UpdateVideoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateVideoArgs_Req_DEFAULT *video.UpdateVideoRequest
+
+func (p *UpdateVideoArgs) GetReq() *video.UpdateVideoRequest {
+	if !p.IsSetReq() {
+		return UpdateVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type UpdateVideoResult struct {
+	Success *video.UpdateVideoResponse
+}
+
+var UpdateVideoResult_Success_DEFAULT *video.UpdateVideoResponse
+
+func (p *UpdateVideoResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.UpdateVideoResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateVideoResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateVideoResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateVideoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in UpdateVideoResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateVideoResult) Unmarshal(in []byte) error {
+	msg := new(video.UpdateVideoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateVideoResult) GetSuccess() *video.UpdateVideoResponse {
+	if !p.IsSetSuccess() {
+		return UpdateVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.UpdateVideoResponse)
+}
+
+func (p *UpdateVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func playActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.PlayActionRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).PlayAction(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *PlayActionArgs:
+		success, err := handler.(video.VideoService).PlayAction(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*PlayActionResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newPlayActionArgs() interface{} {
+	return &PlayActionArgs{}
+}
+
+func newPlayActionResult() interface{} {
+	return &PlayActionResult{}
+}
+
+type PlayActionArgs struct {
+	Req *video.PlayActionRequest
+}
+
+func (p *PlayActionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.PlayActionRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *PlayActionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *PlayActionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *PlayActionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in PlayActionArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *PlayActionArgs) Unmarshal(in []byte) error {
+	msg := new(video.PlayActionRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var PlayActionArgs_Req_DEFAULT *video.PlayActionRequest
+
+func (p *PlayActionArgs) GetReq() *video.PlayActionRequest {
+	if !p.IsSetReq() {
+		return PlayActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *PlayActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type PlayActionResult struct {
+	Success *video.PlayActionResponse
+}
+
+var PlayActionResult_Success_DEFAULT *video.PlayActionResponse
+
+func (p *PlayActionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.PlayActionResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *PlayActionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *PlayActionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *PlayActionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in PlayActionResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *PlayActionResult) Unmarshal(in []byte) error {
+	msg := new(video.PlayActionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *PlayActionResult) GetSuccess() *video.PlayActionResponse {
+	if !p.IsSetSuccess() {
+		return PlayActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *PlayActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.PlayActionResponse)
+}
+
+func (p *PlayActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func topicFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.TopicFeedRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).TopicFeed(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *TopicFeedArgs:
+		success, err := handler.(video.VideoService).TopicFeed(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*TopicFeedResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newTopicFeedArgs() interface{} {
+	return &TopicFeedArgs{}
+}
+
+func newTopicFeedResult() interface{} {
+	return &TopicFeedResult{}
+}
+
+type TopicFeedArgs struct {
+	Req *video.TopicFeedRequest
+}
+
+func (p *TopicFeedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.TopicFeedRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *TopicFeedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *TopicFeedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *TopicFeedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in TopicFeedArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *TopicFeedArgs) Unmarshal(in []byte) error {
+	msg := new(video.TopicFeedRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var TopicFeedArgs_Req_DEFAULT *video.TopicFeedRequest
+
+func (p *TopicFeedArgs) GetReq() *video.TopicFeedRequest {
+	if !p.IsSetReq() {
+		return TopicFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *TopicFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type TopicFeedResult struct {
+	Success *video.TopicFeedResponse
+}
+
+var TopicFeedResult_Success_DEFAULT *video.TopicFeedResponse
+
+func (p *TopicFeedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.TopicFeedResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *TopicFeedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *TopicFeedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *TopicFeedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in TopicFeedResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *TopicFeedResult) Unmarshal(in []byte) error {
+	msg := new(video.TopicFeedResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *TopicFeedResult) GetSuccess() *video.TopicFeedResponse {
+	if !p.IsSetSuccess() {
+		return TopicFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *TopicFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.TopicFeedResponse)
+}
+
+func (p *TopicFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func trendingTagsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.TrendingTagsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).TrendingTags(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *TrendingTagsArgs:
+		success, err := handler.(video.VideoService).TrendingTags(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*TrendingTagsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newTrendingTagsArgs() interface{} {
+	return &TrendingTagsArgs{}
+}
+
+func newTrendingTagsResult() interface{} {
+	return &TrendingTagsResult{}
+}
+
+type TrendingTagsArgs struct {
+	Req *video.TrendingTagsRequest
+}
+
+func (p *TrendingTagsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.TrendingTagsRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *TrendingTagsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *TrendingTagsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *TrendingTagsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in TrendingTagsArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *TrendingTagsArgs) Unmarshal(in []byte) error {
+	msg := new(video.TrendingTagsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var TrendingTagsArgs_Req_DEFAULT *video.TrendingTagsRequest
+
+func (p *TrendingTagsArgs) GetReq() *video.TrendingTagsRequest {
+	if !p.IsSetReq() {
+		return TrendingTagsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *TrendingTagsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type TrendingTagsResult struct {
+	Success *video.TrendingTagsResponse
+}
+
+var TrendingTagsResult_Success_DEFAULT *video.TrendingTagsResponse
+
+func (p *TrendingTagsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.TrendingTagsResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *TrendingTagsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *TrendingTagsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *TrendingTagsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in TrendingTagsResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *TrendingTagsResult) Unmarshal(in []byte) error {
+	msg := new(video.TrendingTagsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *TrendingTagsResult) GetSuccess() *video.TrendingTagsResponse {
+	if !p.IsSetSuccess() {
+		return TrendingTagsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *TrendingTagsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.TrendingTagsResponse)
+}
+
+func (p *TrendingTagsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func shareVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.ShareVideoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).ShareVideo(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ShareVideoArgs:
+		success, err := handler.(video.VideoService).ShareVideo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ShareVideoResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newShareVideoArgs() interface{} {
+	return &ShareVideoArgs{}
+}
+
+func newShareVideoResult() interface{} {
+	return &ShareVideoResult{}
+}
+
+type ShareVideoArgs struct {
+	Req *video.ShareVideoRequest
+}
+
+func (p *ShareVideoArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.ShareVideoRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ShareVideoArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ShareVideoArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ShareVideoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in ShareVideoArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ShareVideoArgs) Unmarshal(in []byte) error {
+	msg := new(video.ShareVideoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ShareVideoArgs_Req_DEFAULT *video.ShareVideoRequest
+
+func (p *ShareVideoArgs) GetReq() *video.ShareVideoRequest {
+	if !p.IsSetReq() {
+		return ShareVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ShareVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type ShareVideoResult struct {
+	Success *video.ShareVideoResponse
+}
+
+var ShareVideoResult_Success_DEFAULT *video.ShareVideoResponse
+
+func (p *ShareVideoResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.ShareVideoResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ShareVideoResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ShareVideoResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ShareVideoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in ShareVideoResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ShareVideoResult) Unmarshal(in []byte) error {
+	msg := new(video.ShareVideoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ShareVideoResult) GetSuccess() *video.ShareVideoResponse {
+	if !p.IsSetSuccess() {
+		return ShareVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ShareVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.ShareVideoResponse)
+}
+
+func (p *ShareVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func resolveShareHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.ResolveShareRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).ResolveShare(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ResolveShareArgs:
+		success, err := handler.(video.VideoService).ResolveShare(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResolveShareResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newResolveShareArgs() interface{} {
+	return &ResolveShareArgs{}
+}
+
+func newResolveShareResult() interface{} {
+	return &ResolveShareResult{}
+}
+
+type ResolveShareArgs struct {
+	Req *video.ResolveShareRequest
+}
+
+func (p *ResolveShareArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.ResolveShareRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ResolveShareArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ResolveShareArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ResolveShareArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in ResolveShareArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResolveShareArgs) Unmarshal(in []byte) error {
+	msg := new(video.ResolveShareRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResolveShareArgs_Req_DEFAULT *video.ResolveShareRequest
+
+func (p *ResolveShareArgs) GetReq() *video.ResolveShareRequest {
+	if !p.IsSetReq() {
+		return ResolveShareArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResolveShareArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type ResolveShareResult struct {
+	Success *video.ResolveShareResponse
+}
+
+var ResolveShareResult_Success_DEFAULT *video.ResolveShareResponse
+
+func (p *ResolveShareResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.ResolveShareResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ResolveShareResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ResolveShareResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ResolveShareResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in ResolveShareResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResolveShareResult) Unmarshal(in []byte) error {
+	msg := new(video.ResolveShareResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResolveShareResult) GetSuccess() *video.ResolveShareResponse {
+	if !p.IsSetSuccess() {
+		return ResolveShareResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResolveShareResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.ResolveShareResponse)
+}
+
+func (p *ResolveShareResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func draftListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.DraftListRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).DraftList(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DraftListArgs:
+		success, err := handler.(video.VideoService).DraftList(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DraftListResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDraftListArgs() interface{} {
+	return &DraftListArgs{}
+}
+
+func newDraftListResult() interface{} {
+	return &DraftListResult{}
+}
+
+type DraftListArgs struct {
+	Req *video.DraftListRequest
+}
+
+func (p *DraftListArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.DraftListRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DraftListArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DraftListArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DraftListArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in DraftListArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DraftListArgs) Unmarshal(in []byte) error {
+	msg := new(video.DraftListRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DraftListArgs_Req_DEFAULT *video.DraftListRequest
+
+func (p *DraftListArgs) GetReq() *video.DraftListRequest {
+	if !p.IsSetReq() {
+		return DraftListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DraftListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type DraftListResult struct {
+	Success *video.DraftListResponse
+}
+
+var DraftListResult_Success_DEFAULT *video.DraftListResponse
+
+func (p *DraftListResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.DraftListResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DraftListResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DraftListResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DraftListResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in DraftListResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DraftListResult) Unmarshal(in []byte) error {
+	msg := new(video.DraftListResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DraftListResult) GetSuccess() *video.DraftListResponse {
+	if !p.IsSetSuccess() {
+		return DraftListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DraftListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.DraftListResponse)
+}
+
+func (p *DraftListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func publishDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(video.PublishDraftRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(video.VideoService).PublishDraft(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *PublishDraftArgs:
+		success, err := handler.(video.VideoService).PublishDraft(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*PublishDraftResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newPublishDraftArgs() interface{} {
+	return &PublishDraftArgs{}
+}
+
+func newPublishDraftResult() interface{} {
+	return &PublishDraftResult{}
+}
+
+type PublishDraftArgs struct {
+	Req *video.PublishDraftRequest
+}
+
+func (p *PublishDraftArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(video.PublishDraftRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *PublishDraftArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *PublishDraftArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *PublishDraftArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in PublishDraftArgs")
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *PublishDraftArgs) Unmarshal(in []byte) error {
+	msg := new(video.PublishDraftRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var PublishDraftArgs_Req_DEFAULT *video.PublishDraftRequest
+
+func (p *PublishDraftArgs) GetReq() *video.PublishDraftRequest {
+	if !p.IsSetReq() {
+		return PublishDraftArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *PublishDraftArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type PublishDraftResult struct {
+	Success *video.PublishDraftResponse
+}
+
+var PublishDraftResult_Success_DEFAULT *video.PublishDraftResponse
+
+func (p *PublishDraftResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(video.PublishDraftResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *PublishDraftResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *PublishDraftResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *PublishDraftResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in PublishDraftResult")
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *PublishDraftResult) Unmarshal(in []byte) error {
+	msg := new(video.PublishDraftResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *PublishDraftResult) GetSuccess() *video.PublishDraftResponse {
+	if !p.IsSetSuccess() {
+		return PublishDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *PublishDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*video.PublishDraftResponse)
+}
+
+func (p *PublishDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -514,3 +2558,143 @@ func (p *kClient) PublishList(ctx context.Context, Req *video.PublishListRequest
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) UploadInit(ctx context.Context, Req *video.UploadInitRequest) (r *video.UploadInitResponse, err error) {
+	var _args UploadInitArgs
+	_args.Req = Req
+	var _result UploadInitResult
+	if err = p.c.Call(ctx, "UploadInit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadPart(ctx context.Context, Req *video.UploadPartRequest) (r *video.UploadPartResponse, err error) {
+	var _args UploadPartArgs
+	_args.Req = Req
+	var _result UploadPartResult
+	if err = p.c.Call(ctx, "UploadPart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadComplete(ctx context.Context, Req *video.UploadCompleteRequest) (r *video.UploadCompleteResponse, err error) {
+	var _args UploadCompleteArgs
+	_args.Req = Req
+	var _result UploadCompleteResult
+	if err = p.c.Call(ctx, "UploadComplete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadAbort(ctx context.Context, Req *video.UploadAbortRequest) (r *video.UploadAbortResponse, err error) {
+	var _args UploadAbortArgs
+	_args.Req = Req
+	var _result UploadAbortResult
+	if err = p.c.Call(ctx, "UploadAbort", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PublishStatus(ctx context.Context, Req *video.PublishStatusRequest) (r *video.PublishStatusResponse, err error) {
+	var _args PublishStatusArgs
+	_args.Req = Req
+	var _result PublishStatusResult
+	if err = p.c.Call(ctx, "PublishStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteVideo(ctx context.Context, Req *video.DeleteVideoRequest) (r *video.DeleteVideoResponse, err error) {
+	var _args DeleteVideoArgs
+	_args.Req = Req
+	var _result DeleteVideoResult
+	if err = p.c.Call(ctx, "DeleteVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateVideo(ctx context.Context, Req *video.UpdateVideoRequest) (r *video.UpdateVideoResponse, err error) {
+	var _args UpdateVideoArgs
+	_args.Req = Req
+	var _result UpdateVideoResult
+	if err = p.c.Call(ctx, "UpdateVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PlayAction(ctx context.Context, Req *video.PlayActionRequest) (r *video.PlayActionResponse, err error) {
+	var _args PlayActionArgs
+	_args.Req = Req
+	var _result PlayActionResult
+	if err = p.c.Call(ctx, "PlayAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TopicFeed(ctx context.Context, Req *video.TopicFeedRequest) (r *video.TopicFeedResponse, err error) {
+	var _args TopicFeedArgs
+	_args.Req = Req
+	var _result TopicFeedResult
+	if err = p.c.Call(ctx, "TopicFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TrendingTags(ctx context.Context, Req *video.TrendingTagsRequest) (r *video.TrendingTagsResponse, err error) {
+	var _args TrendingTagsArgs
+	_args.Req = Req
+	var _result TrendingTagsResult
+	if err = p.c.Call(ctx, "TrendingTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ShareVideo(ctx context.Context, Req *video.ShareVideoRequest) (r *video.ShareVideoResponse, err error) {
+	var _args ShareVideoArgs
+	_args.Req = Req
+	var _result ShareVideoResult
+	if err = p.c.Call(ctx, "ShareVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResolveShare(ctx context.Context, Req *video.ResolveShareRequest) (r *video.ResolveShareResponse, err error) {
+	var _args ResolveShareArgs
+	_args.Req = Req
+	var _result ResolveShareResult
+	if err = p.c.Call(ctx, "ResolveShare", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DraftList(ctx context.Context, Req *video.DraftListRequest) (r *video.DraftListResponse, err error) {
+	var _args DraftListArgs
+	_args.Req = Req
+	var _result DraftListResult
+	if err = p.c.Call(ctx, "DraftList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PublishDraft(ctx context.Context, Req *video.PublishDraftRequest) (r *video.PublishDraftResponse, err error) {
+	var _args PublishDraftArgs
+	_args.Req = Req
+	var _result PublishDraftResult
+	if err = p.c.Call(ctx, "PublishDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -26,6 +26,54 @@ package errno
 
 import code "github.com/a76yyyy/ErrnoCode"
 
+// 服务: 视频内容校验类错误，ErrnoCode 中未定义，在此补充
+const (
+	// ErrVideoInvalidFormat - 400: Unsupported video format.
+	ErrCodeVideoInvalidFormat int = iota + 120101
+
+	// ErrVideoDurationExceeded - 400: Video duration exceeds the limit.
+	ErrCodeVideoDurationExceeded
+
+	// ErrVideoSizeExceeded - 400: Video size exceeds the limit.
+	ErrCodeVideoSizeExceeded
+
+	// ErrVideoResolutionExceeded - 400: Video resolution exceeds the limit.
+	ErrCodeVideoResolutionExceeded
+
+	// ErrCoverInvalidFormat - 400: Unsupported cover image format.
+	ErrCodeCoverInvalidFormat
+
+	// ErrCoverSizeExceeded - 400: Cover image size exceeds the limit.
+	ErrCodeCoverSizeExceeded
+
+	// ErrCoverResolutionExceeded - 400: Cover image resolution exceeds the limit.
+	ErrCodeCoverResolutionExceeded
+)
+
+// 服务: 用户头像及背景图校验类错误
+const (
+	// ErrImageInvalidFormat - 400: Unsupported image format.
+	ErrCodeImageInvalidFormat int = iota + 120201
+
+	// ErrImageSizeExceeded - 400: Image size exceeds the limit.
+	ErrCodeImageSizeExceeded
+
+	// ErrImageResolutionExceeded - 400: Image resolution exceeds the limit.
+	ErrCodeImageResolutionExceeded
+)
+
+// 服务: 登录失败次数限制类错误
+const (
+	// ErrLoginBackoff - 429: Too many failed login attempts, retry later.
+	ErrCodeLoginBackoff int = iota + 120301
+
+	// ErrAccountLocked - 423: Account temporarily locked due to too many failed login attempts.
+	ErrCodeAccountLocked
+
+	// ErrLoginIPLocked - 429: Too many failed login attempts from this IP.
+	ErrCodeLoginIPLocked
+)
+
 // HTTP Error
 var (
 	HttpSuccess                  = NewHttpErr(code.ErrSuccess, 200, "OK")
@@ -74,6 +122,20 @@ var (
 	ErrHttpVideoNotFound         = NewHttpErr(code.ErrVideoNotFound, 400, "Video not found")
 	ErrHttpInvalidHash           = NewHttpErr(code.ErrInvalidHash, 500, "Encoded hash is not in the correct format")
 	ErrHttpIncompatibleVersion   = NewHttpErr(code.ErrIncompatibleVersion, 500, "Encoded hash is not in the correct format")
+
+	ErrHttpVideoInvalidFormat      = NewHttpErr(ErrCodeVideoInvalidFormat, 400, "Unsupported video format")
+	ErrHttpVideoDurationExceeded   = NewHttpErr(ErrCodeVideoDurationExceeded, 400, "Video duration exceeds the limit")
+	ErrHttpVideoSizeExceeded       = NewHttpErr(ErrCodeVideoSizeExceeded, 400, "Video size exceeds the limit")
+	ErrHttpVideoResolutionExceeded = NewHttpErr(ErrCodeVideoResolutionExceeded, 400, "Video resolution exceeds the limit")
+	ErrHttpCoverInvalidFormat      = NewHttpErr(ErrCodeCoverInvalidFormat, 400, "Unsupported cover image format")
+	ErrHttpCoverSizeExceeded       = NewHttpErr(ErrCodeCoverSizeExceeded, 400, "Cover image size exceeds the limit")
+	ErrHttpCoverResolutionExceeded = NewHttpErr(ErrCodeCoverResolutionExceeded, 400, "Cover image resolution exceeds the limit")
+	ErrHttpImageInvalidFormat      = NewHttpErr(ErrCodeImageInvalidFormat, 400, "Unsupported image format")
+	ErrHttpImageSizeExceeded       = NewHttpErr(ErrCodeImageSizeExceeded, 400, "Image size exceeds the limit")
+	ErrHttpImageResolutionExceeded = NewHttpErr(ErrCodeImageResolutionExceeded, 400, "Image resolution exceeds the limit")
+	ErrHttpLoginBackoff            = NewHttpErr(ErrCodeLoginBackoff, 429, "Too many failed login attempts, retry later")
+	ErrHttpAccountLocked           = NewHttpErr(ErrCodeAccountLocked, 423, "Account temporarily locked")
+	ErrHttpLoginIPLocked           = NewHttpErr(ErrCodeLoginIPLocked, 429, "Too many failed login attempts from this IP")
 )
 
 // Server Error
@@ -128,4 +190,18 @@ var (
 	ErrVideoNotFound       = NewErrNo(code.ErrVideoNotFound, "Video not found")
 	ErrInvalidHash         = NewErrNo(code.ErrInvalidHash, "Encoded hash is not in the correct format")
 	ErrIncompatibleVersion = NewErrNo(code.ErrIncompatibleVersion, "Encoded hash is not in the correct format")
+
+	ErrVideoInvalidFormat      = NewErrNo(ErrCodeVideoInvalidFormat, "Unsupported video format")
+	ErrVideoDurationExceeded   = NewErrNo(ErrCodeVideoDurationExceeded, "Video duration exceeds the limit")
+	ErrVideoSizeExceeded       = NewErrNo(ErrCodeVideoSizeExceeded, "Video size exceeds the limit")
+	ErrVideoResolutionExceeded = NewErrNo(ErrCodeVideoResolutionExceeded, "Video resolution exceeds the limit")
+	ErrCoverInvalidFormat      = NewErrNo(ErrCodeCoverInvalidFormat, "Unsupported cover image format")
+	ErrCoverSizeExceeded       = NewErrNo(ErrCodeCoverSizeExceeded, "Cover image size exceeds the limit")
+	ErrCoverResolutionExceeded = NewErrNo(ErrCodeCoverResolutionExceeded, "Cover image resolution exceeds the limit")
+	ErrImageInvalidFormat      = NewErrNo(ErrCodeImageInvalidFormat, "Unsupported image format")
+	ErrImageSizeExceeded       = NewErrNo(ErrCodeImageSizeExceeded, "Image size exceeds the limit")
+	ErrImageResolutionExceeded = NewErrNo(ErrCodeImageResolutionExceeded, "Image resolution exceeds the limit")
+	ErrLoginBackoff            = NewErrNo(ErrCodeLoginBackoff, "Too many failed login attempts, retry later")
+	ErrAccountLocked           = NewErrNo(ErrCodeAccountLocked, "Account temporarily locked")
+	ErrLoginIPLocked           = NewErrNo(ErrCodeLoginIPLocked, "Too many failed login attempts from this IP")
 )
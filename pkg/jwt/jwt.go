@@ -1,14 +1,37 @@
 package jwt
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// 令牌类型，未携带类型的旧令牌视为访问令牌
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	// TokenTypeChallenge 开启两步验证的用户通过密码校验后获得的挑战令牌，只能用于完成两步验证
+	TokenTypeChallenge = "challenge"
+)
+
+// 认证强度，未携带的旧令牌视为仅通过密码认证
+const (
+	AuthLevelPassword = 1 // 仅通过密码认证
+	AuthLevelMFA      = 2 // 通过密码及两步验证
 )
 
 // JWT signing Key
 type JWT struct {
+	// SigningKey HS256 共享密钥，设置 Keys 后不再使用
 	SigningKey []byte
+	// Keys 非对称签名密钥，按令牌头部的 kid 选择校验公钥
+	Keys *KeySet
+	// Revoker 令牌吊销列表，为 nil 时不检查令牌是否已吊销
+	Revoker Revoker
 }
 
 var (
@@ -16,31 +39,169 @@ var (
 	ErrTokenNotValidYet = errors.New("token is not active yet")
 	ErrTokenMalformed   = errors.New("that's not even a token")
 	ErrTokenInvalid     = errors.New("couldn't handle this token")
+	ErrTokenRevoked     = errors.New("token has been revoked")
+	ErrTokenType        = errors.New("unexpected token type")
+	ErrAuthLevel        = errors.New("insufficient authentication level")
 )
 
 // CustomClaims Structured version of Claims Section, as referenced at https://tools.ietf.org/html/rfc7519#section-4.1 See examples for how to use this with your own claim types
 type CustomClaims struct {
-	Id int64
+	Id   int64
+	Type string `json:"typ,omitempty"` // 令牌类型
+	// SessionID 登录会话 id，同一会话中刷新得到的令牌共用，吊销会话时会话内的全部令牌失效
+	SessionID string `json:"sid,omitempty"`
+	// AuthLevel 签发令牌时的认证强度，同一会话中刷新得到的令牌保持不变
+	AuthLevel int `json:"aal,omitempty"`
 	jwt.StandardClaims
 }
 
+// TokenID 返回令牌的唯一标识 jti
+func (c *CustomClaims) TokenID() string {
+	return c.StandardClaims.Id
+}
+
+// Level 返回令牌的认证强度
+func (c *CustomClaims) Level() int {
+	if c.AuthLevel == 0 {
+		return AuthLevelPassword
+	}
+	return c.AuthLevel
+}
+
+// RequireAuthLevel 敏感操作要求令牌的认证强度不低于 level
+func (c *CustomClaims) RequireAuthLevel(level int) error {
+	if c.Level() < level {
+		return ErrAuthLevel
+	}
+	return nil
+}
+
+// TokenPair 访问令牌与刷新令牌
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
 func NewJWT(SigningKey []byte) *JWT {
 	return &JWT{
-		SigningKey,
+		SigningKey: SigningKey,
+	}
+}
+
+// NewJWTWithKeys 加载非对称签名密钥创建 JWT，并按配置定期重新加载密钥
+func NewJWTWithKeys(config KeyConfig) (*JWT, error) {
+	keys, err := LoadKeySet(config.KeyDir, config.SigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	keys.Watch(config.ReloadInterval)
+	return &JWT{
+		Keys: keys,
+	}, nil
+}
+
+// MustNewJWTWithKeys 同 NewJWTWithKeys，密钥加载失败时 panic，用于服务启动
+func MustNewJWTWithKeys(config KeyConfig) *JWT {
+	j, err := NewJWTWithKeys(config)
+	if err != nil {
+		panic(fmt.Errorf("load jwt keys from %s: %w, run scripts/jwt-key.sh to generate keys", config.KeyDir, err))
 	}
+	return j
 }
 
 // create a new token
 func (j *JWT) CreateToken(claims CustomClaims) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(j.SigningKey)
+	if j.Keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString(j.SigningKey)
+	}
+	kid, method, key, err := j.Keys.signer()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
 }
 
-// ParseToken parse the token.
-func (j *JWT) ParseToken(tokenString string) (*CustomClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+// keyFunc 返回校验令牌签名的密钥，签名算法必须与密钥类型一致，防止算法混淆攻击
+func (j *JWT) keyFunc(token *jwt.Token) (interface{}, error) {
+	if j.Keys == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrTokenInvalid
+		}
 		return j.SigningKey, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	k, ok := j.Keys.verifier(kid)
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != k.method.Alg() {
+		return nil, ErrTokenInvalid
+	}
+	return k.key, nil
+}
+
+// CreateTokenPair 为用户签发属于会话 sessionID、认证强度为 authLevel 的访问令牌与刷新令牌，两者均带有唯一的 jti 以便吊销
+func (j *JWT) CreateTokenPair(id int64, sessionID string, authLevel int, accessTTL, refreshTTL time.Duration) (*TokenPair, error) {
+	now := time.Now()
+	pair := &TokenPair{
+		AccessExpiresAt:  now.Add(accessTTL),
+		RefreshExpiresAt: now.Add(refreshTTL),
+	}
+	var err error
+	pair.AccessToken, err = j.CreateToken(CustomClaims{
+		Id:        id,
+		Type:      TokenTypeAccess,
+		SessionID: sessionID,
+		AuthLevel: authLevel,
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: pair.AccessExpiresAt.Unix(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	pair.RefreshToken, err = j.CreateToken(CustomClaims{
+		Id:        id,
+		Type:      TokenTypeRefresh,
+		SessionID: sessionID,
+		AuthLevel: authLevel,
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: pair.RefreshExpiresAt.Unix(),
+		},
 	})
+	if err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// CreateChallengeToken 签发两步验证的挑战令牌，带有 jti 以保证只能使用一次
+func (j *JWT) CreateChallengeToken(id int64, ttl time.Duration) (string, error) {
+	now := time.Now()
+	return j.CreateToken(CustomClaims{
+		Id:        id,
+		Type:      TokenTypeChallenge,
+		AuthLevel: AuthLevelPassword,
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+	})
+}
+
+// parse 校验令牌签名、有效期及吊销状态
+func (j *JWT) parse(tokenString string) (*CustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, j.keyFunc)
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
 			if ve.Errors&jwt.ValidationErrorMalformed != 0 {
@@ -49,14 +210,82 @@ func (j *JWT) ParseToken(tokenString string) (*CustomClaims, error) {
 				return nil, ErrTokenExpired
 			} else if ve.Errors&jwt.ValidationErrorNotValidYet != 0 {
 				return nil, ErrTokenNotValidYet
-			} else {
-				return nil, ErrTokenInvalid
 			}
-
 		}
+		return nil, ErrTokenInvalid
+	}
+	claims, ok := token.Claims.(*CustomClaims)
+	if !ok || !token.Valid {
+		return nil, ErrTokenInvalid
+	}
+	if j.Revoker != nil && (claims.TokenID() != "" || claims.SessionID != "") {
+		var ids []string
+		if claims.TokenID() != "" {
+			ids = append(ids, claims.TokenID())
+		}
+		if claims.SessionID != "" {
+			ids = append(ids, sessionRevokeID(claims.SessionID))
+		}
+		revoked, err := j.Revoker.IsRevoked(context.Background(), ids...)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
+	}
+	return claims, nil
+}
+
+// ParseToken parse the access token.
+func (j *JWT) ParseToken(tokenString string) (*CustomClaims, error) {
+	claims, err := j.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != "" && claims.Type != TokenTypeAccess {
+		return nil, ErrTokenType
+	}
+	return claims, nil
+}
+
+// ParseRefreshToken 解析刷新令牌，访问令牌不能用于刷新
+func (j *JWT) ParseRefreshToken(tokenString string) (*CustomClaims, error) {
+	claims, err := j.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != TokenTypeRefresh {
+		return nil, ErrTokenType
+	}
+	return claims, nil
+}
+
+// ParseChallengeToken 解析两步验证的挑战令牌
+func (j *JWT) ParseChallengeToken(tokenString string) (*CustomClaims, error) {
+	claims, err := j.parse(tokenString)
+	if err != nil {
+		return nil, err
 	}
-	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
-		return claims, nil
+	if claims.Type != TokenTypeChallenge {
+		return nil, ErrTokenType
+	}
+	return claims, nil
+}
+
+// RevokeToken 吊销令牌直至其过期，返回令牌此前是否未被吊销，可用于保证刷新令牌只能使用一次
+func (j *JWT) RevokeToken(ctx context.Context, claims *CustomClaims) (bool, error) {
+	if j.Revoker == nil || claims.TokenID() == "" {
+		return false, ErrTokenInvalid
+	}
+	return j.Revoker.Revoke(ctx, claims.TokenID(), time.Unix(claims.ExpiresAt, 0))
+}
+
+// RevokeSession 吊销会话直至 expiresAt，会话内已签发的访问令牌与刷新令牌全部失效
+func (j *JWT) RevokeSession(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	if j.Revoker == nil || sessionID == "" {
+		return ErrTokenInvalid
 	}
-	return nil, ErrTokenInvalid
+	_, err := j.Revoker.Revoke(ctx, sessionRevokeID(sessionID), expiresAt)
+	return err
 }
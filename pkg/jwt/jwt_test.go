@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,8 +13,8 @@ func TestJWT(t *testing.T) {
 	userJwt := NewJWT([]byte{0x12, 0x32, 0x4a, 0x53, 0x59, 0x45})
 
 	token, err := userJwt.CreateToken(CustomClaims{
-		1234,
-		jwt.StandardClaims{
+		Id: 1234,
+		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(time.Second * 5).Unix(),
 			Issuer:    "dousheng",
 		},
@@ -44,3 +46,133 @@ func TestJWT(t *testing.T) {
 	}
 
 }
+
+type memoryRevoker struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+}
+
+func (r *memoryRevoker) Revoke(_ context.Context, jti string, expiresAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.revoked[jti]; ok {
+		return false, nil
+	}
+	r.revoked[jti] = expiresAt
+	return true, nil
+}
+
+func (r *memoryRevoker) IsRevoked(_ context.Context, ids ...string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := r.revoked[id]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestTokenPair(t *testing.T) {
+	userJwt := NewJWT([]byte("signingKey"))
+	userJwt.Revoker = &memoryRevoker{revoked: make(map[string]time.Time)}
+
+	pair, err := userJwt.CreateTokenPair(1234, "session-1", AuthLevelPassword, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("create token pair error %v", err)
+	}
+
+	access, err := userJwt.ParseToken(pair.AccessToken)
+	if err != nil || access.Id != 1234 || access.TokenID() == "" || access.SessionID != "session-1" {
+		t.Fatalf("parse access token = %+v, %v", access, err)
+	}
+	if _, err = userJwt.ParseToken(pair.RefreshToken); err != ErrTokenType {
+		t.Fatalf("refresh token used as access token, err = %v", err)
+	}
+	if _, err = userJwt.ParseRefreshToken(pair.AccessToken); err != ErrTokenType {
+		t.Fatalf("access token used as refresh token, err = %v", err)
+	}
+	refresh, err := userJwt.ParseRefreshToken(pair.RefreshToken)
+	if err != nil || refresh.Id != 1234 || refresh.TokenID() == access.TokenID() {
+		t.Fatalf("parse refresh token = %+v, %v", refresh, err)
+	}
+
+	// 刷新令牌只能使用一次
+	if first, err := userJwt.RevokeToken(context.Background(), refresh); !first || err != nil {
+		t.Fatalf("revoke refresh token = %v, %v", first, err)
+	}
+	if first, err := userJwt.RevokeToken(context.Background(), refresh); first || err != nil {
+		t.Fatalf("revoke refresh token twice = %v, %v", first, err)
+	}
+	if _, err = userJwt.ParseRefreshToken(pair.RefreshToken); err != ErrTokenRevoked {
+		t.Fatalf("revoked refresh token err = %v", err)
+	}
+	if _, err = userJwt.ParseToken(pair.AccessToken); err != nil {
+		t.Fatalf("access token revoked with refresh token, err = %v", err)
+	}
+
+	// 吊销会话后会话内的全部令牌失效，其他会话不受影响
+	other, err := userJwt.CreateTokenPair(1234, "session-2", AuthLevelMFA, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("create token pair error %v", err)
+	}
+	if err = userJwt.RevokeSession(context.Background(), "session-1", pair.RefreshExpiresAt); err != nil {
+		t.Fatalf("revoke session error %v", err)
+	}
+	if _, err = userJwt.ParseToken(pair.AccessToken); err != ErrTokenRevoked {
+		t.Fatalf("access token of revoked session err = %v", err)
+	}
+	if _, err = userJwt.ParseToken(other.AccessToken); err != nil {
+		t.Fatalf("access token of other session err = %v", err)
+	}
+	if _, err = userJwt.ParseRefreshToken(other.RefreshToken); err != nil {
+		t.Fatalf("refresh token of other session err = %v", err)
+	}
+
+	// 未携带类型及 jti 的旧令牌仍可作为访问令牌使用
+	legacy, _ := userJwt.CreateToken(CustomClaims{Id: 1, StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Minute).Unix()}})
+	if _, err = userJwt.ParseToken(legacy); err != nil {
+		t.Fatalf("legacy token err = %v", err)
+	}
+	if _, err = userJwt.RevokeToken(context.Background(), &CustomClaims{}); err != ErrTokenInvalid {
+		t.Fatalf("revoke token without jti err = %v", err)
+	}
+}
+
+func TestChallengeToken(t *testing.T) {
+	userJwt := NewJWT([]byte("signingKey"))
+
+	challenge, err := userJwt.CreateChallengeToken(1234, time.Minute)
+	if err != nil {
+		t.Fatalf("create challenge token error %v", err)
+	}
+	if _, err = userJwt.ParseToken(challenge); err != ErrTokenType {
+		t.Fatalf("challenge token used as access token, err = %v", err)
+	}
+	if _, err = userJwt.ParseRefreshToken(challenge); err != ErrTokenType {
+		t.Fatalf("challenge token used as refresh token, err = %v", err)
+	}
+	claims, err := userJwt.ParseChallengeToken(challenge)
+	if err != nil || claims.Id != 1234 || claims.TokenID() == "" {
+		t.Fatalf("parse challenge token = %+v, %v", claims, err)
+	}
+
+	// 认证强度
+	pair, err := userJwt.CreateTokenPair(1234, "session-1", AuthLevelMFA, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("create token pair error %v", err)
+	}
+	if _, err = userJwt.ParseChallengeToken(pair.AccessToken); err != ErrTokenType {
+		t.Fatalf("access token used as challenge token, err = %v", err)
+	}
+	access, err := userJwt.ParseToken(pair.AccessToken)
+	if err != nil || access.RequireAuthLevel(AuthLevelMFA) != nil {
+		t.Fatalf("mfa access token = %+v, %v", access, err)
+	}
+	if err = claims.RequireAuthLevel(AuthLevelMFA); err != ErrAuthLevel {
+		t.Fatalf("password level token err = %v", err)
+	}
+	if legacy := (&CustomClaims{}); legacy.Level() != AuthLevelPassword || legacy.RequireAuthLevel(AuthLevelPassword) != nil {
+		t.Fatalf("legacy token level = %d", legacy.Level())
+	}
+}
@@ -0,0 +1,225 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/zap"
+	"github.com/golang-jwt/jwt"
+)
+
+// publicKeySuffix 公钥文件的后缀，文件名为 {kid}.pub.pem
+const publicKeySuffix = ".pub.pem"
+
+var (
+	ErrNoSigningKey   = errors.New("no signing key loaded")
+	ErrUnknownKey     = errors.New("unknown key id")
+	ErrKeyUnsupported = errors.New("unsupported key type, only RSA and Ed25519 are supported")
+	ErrKeyMismatch    = errors.New("signing key does not match the public key with the same key id")
+)
+
+// KeyConfig 非对称签名密钥配置
+type KeyConfig struct {
+	// KeyDir 公钥目录，目录下每个 {kid}.pub.pem 均可用于校验令牌
+	KeyDir string
+	// SigningKeyFile 签名私钥文件，kid 为去掉 .pem 后缀的文件名，仅签发令牌的服务需要配置。
+	// 可以是指向 {kid}.pem 的符号链接，重新加载时按链接当前指向的文件确定签名密钥及 kid
+	SigningKeyFile string
+	// ReloadInterval 重新加载密钥的间隔，为 0 时不自动加载
+	ReloadInterval time.Duration
+}
+
+// LoadKeyConfig 读取配置中的 JWT 密钥配置，相对路径以配置文件所在目录为准
+func LoadKeyConfig(config viper.Config) KeyConfig {
+	dir := filepath.Dir(config.Viper.ConfigFileUsed())
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+	return KeyConfig{
+		KeyDir:         resolve(config.Viper.GetString("JWT.keyDir")),
+		SigningKeyFile: resolve(config.Viper.GetString("JWT.signingKeyFile")),
+		ReloadInterval: config.Viper.GetDuration("JWT.reloadInterval"),
+	}
+}
+
+// verifyKey 用于校验令牌的公钥
+type verifyKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+}
+
+// KeySet 令牌签名与校验使用的密钥集合，允许同时存在多个公钥，以便轮换密钥时旧令牌仍可校验
+//
+// 轮换步骤：先将新公钥分发到所有服务的公钥目录，待各服务重新加载后，将签名私钥的符号链接指向新密钥，
+// 签名服务在下次重新加载时切换，无需重启；旧令牌全部过期后再删除旧公钥。
+type KeySet struct {
+	dir         string
+	signingFile string
+
+	mu         sync.RWMutex
+	keys       map[string]verifyKey
+	signKid    string
+	signMethod jwt.SigningMethod
+	signKey    crypto.PrivateKey
+}
+
+// LoadKeySet 从公钥目录及签名私钥文件加载密钥，signingKeyFile 为空时只能校验令牌
+func LoadKeySet(dir, signingKeyFile string) (*KeySet, error) {
+	ks := &KeySet{
+		dir:         dir,
+		signingFile: signingKeyFile,
+	}
+	if err := ks.Reload(); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// methodOf 根据密钥类型确定签名算法，RSA 使用 RS256，Ed25519 使用 EdDSA
+func methodOf(key interface{}) (jwt.SigningMethod, error) {
+	switch key.(type) {
+	case *rsa.PublicKey, *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey, ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, ErrKeyUnsupported
+	}
+}
+
+// parsePublicKey 解析 PEM 格式的 RSA 或 Ed25519 公钥
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	return nil, ErrKeyUnsupported
+}
+
+// parsePrivateKey 解析 PEM 格式的 RSA 或 Ed25519 私钥
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+	}
+	return nil, ErrKeyUnsupported
+}
+
+// Reload 重新加载全部密钥，加载失败时保留原有密钥
+func (ks *KeySet) Reload() error {
+	files, err := filepath.Glob(filepath.Join(ks.dir, "*"+publicKeySuffix))
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]verifyKey, len(files))
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), publicKeySuffix)
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		key, err := parsePublicKey(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		method, err := methodOf(key)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		keys[kid] = verifyKey{method: method, key: key}
+	}
+
+	var (
+		signKid    string
+		signMethod jwt.SigningMethod
+		signKey    crypto.Signer
+	)
+	if ks.signingFile != "" {
+		// 每次加载时解析符号链接，kid 取链接指向的文件名
+		file, err := filepath.EvalSymlinks(ks.signingFile)
+		if err != nil {
+			return err
+		}
+		signKid = strings.TrimSuffix(filepath.Base(file), ".pem")
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if signKey, err = parsePrivateKey(data); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if signMethod, err = methodOf(signKey); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		// 签名服务总能校验自己签发的令牌，公钥目录中存在同名公钥时两者必须匹配
+		pub := signKey.Public()
+		if k, ok := keys[signKid]; ok {
+			if eq, ok := k.key.(interface{ Equal(crypto.PublicKey) bool }); !ok || !eq.Equal(pub) {
+				return fmt.Errorf("%s: %w", file, ErrKeyMismatch)
+			}
+		}
+		keys[signKid] = verifyKey{method: signMethod, key: pub}
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no key found in %s", ks.dir)
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys = keys
+	ks.signKid, ks.signMethod, ks.signKey = signKid, signMethod, signKey
+	return nil
+}
+
+// Watch 定期重新加载密钥，新增或删除公钥及切换签名密钥均无需重启服务
+func (ks *KeySet) Watch(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		logger := zap.InitLogger()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := ks.Reload(); err != nil {
+				logger.Errorf("JWT 密钥重新加载失败：%v", err.Error())
+			}
+		}
+	}()
+}
+
+// signer 返回当前的签名密钥
+func (ks *KeySet) signer() (string, jwt.SigningMethod, crypto.PrivateKey, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	if ks.signKey == nil {
+		return "", nil, nil, ErrNoSigningKey
+	}
+	return ks.signKid, ks.signMethod, ks.signKey, nil
+}
+
+// verifier 根据 kid 查找校验令牌的公钥
+func (ks *KeySet) verifier(kid string) (verifyKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	k, ok := ks.keys[kid]
+	return k, ok
+}
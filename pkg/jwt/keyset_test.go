@@ -0,0 +1,220 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// writeKeyPair 生成密钥对，公钥写入 dir/{kid}.pub.pem，私钥写入 privateDir/{kid}.pem
+func writeKeyPair(t *testing.T, dir, privateDir, kid string, priv crypto.Signer) string {
+	t.Helper()
+	pub, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, kid+publicKeySuffix), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(privateDir, kid+".pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func testClaims() CustomClaims {
+	return CustomClaims{
+		Id: 1234,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		},
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	dir, privateDir := t.TempDir(), t.TempDir()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldFile := writeKeyPair(t, dir, privateDir, "k1", edKey)
+
+	oldSigner, err := NewJWTWithKeys(KeyConfig{KeyDir: dir, SigningKeyFile: oldFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := NewJWTWithKeys(KeyConfig{KeyDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.CreateToken(testClaims()); err != ErrNoSigningKey {
+		t.Fatalf("verifier without signing key should not create token, got %v", err)
+	}
+
+	oldToken, err := oldSigner.CreateToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.ParseToken(oldToken); err != nil {
+		t.Fatalf("parse EdDSA token error %v", err)
+	}
+
+	// 分发新公钥后切换签名密钥，旧令牌仍可校验
+	newFile := writeKeyPair(t, dir, privateDir, "k2", rsaKey)
+	newSigner, err := NewJWTWithKeys(KeyConfig{KeyDir: dir, SigningKeyFile: newFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	newToken, err := newSigner.CreateToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.ParseToken(newToken); err != ErrTokenInvalid {
+		t.Fatalf("token signed by unloaded key should be invalid, got %v", err)
+	}
+	if err := verifier.Keys.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{oldToken, newToken} {
+		if _, err := verifier.ParseToken(token); err != nil {
+			t.Fatalf("parse token after reload error %v", err)
+		}
+	}
+
+	// 删除旧公钥后旧令牌失效
+	if err := os.Remove(filepath.Join(dir, "k1"+publicKeySuffix)); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifier.Keys.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.ParseToken(oldToken); err != ErrTokenInvalid {
+		t.Fatalf("token signed by removed key should be invalid, got %v", err)
+	}
+	if _, err := verifier.ParseToken(newToken); err != nil {
+		t.Fatalf("parse RS256 token error %v", err)
+	}
+}
+
+func TestKeySetSwitchSigningKey(t *testing.T) {
+	dir, privateDir := t.TempDir(), t.TempDir()
+	_, k1, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, k2, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeKeyPair(t, dir, privateDir, "k1", k1)
+	writeKeyPair(t, dir, privateDir, "k2", k2)
+	current := filepath.Join(privateDir, "current.pem")
+	if err := os.Symlink("k1.pem", current); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := NewJWTWithKeys(KeyConfig{KeyDir: dir, SigningKeyFile: current})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid, _, _, _ := signer.Keys.signer(); kid != "k1" {
+		t.Fatalf("signing kid = %s, want k1", kid)
+	}
+	oldToken, err := signer.CreateToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 切换符号链接后重新加载，使用新密钥签名，旧令牌仍可校验
+	tmp := filepath.Join(privateDir, "current.tmp")
+	if err := os.Symlink("k2.pem", tmp); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, current); err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.Keys.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if kid, _, _, _ := signer.Keys.signer(); kid != "k2" {
+		t.Fatalf("signing kid after switch = %s, want k2", kid)
+	}
+	newToken, err := signer.CreateToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{oldToken, newToken} {
+		if _, err := signer.ParseToken(token); err != nil {
+			t.Fatalf("parse token after switch error %v", err)
+		}
+	}
+}
+
+func TestKeySetRejectsForgedToken(t *testing.T) {
+	dir, privateDir := t.TempDir(), t.TempDir()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := writeKeyPair(t, dir, privateDir, "k1", edKey)
+	signer, err := NewJWTWithKeys(KeyConfig{KeyDir: dir, SigningKeyFile: file})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 使用公钥内容作为 HMAC 密钥伪造令牌
+	pub, err := os.ReadFile(filepath.Join(dir, "k1"+publicKeySuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	token.Header["kid"] = "k1"
+	forged, err := token.SignedString(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.ParseToken(forged); err != ErrTokenInvalid {
+		t.Fatalf("HS256 token should be rejected, got %v", err)
+	}
+
+	// 缺少 kid 的令牌无法校验
+	token = jwt.NewWithClaims(jwt.SigningMethodEdDSA, testClaims())
+	noKid, err := token.SignedString(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.ParseToken(noKid); err != ErrTokenInvalid {
+		t.Fatalf("token without kid should be rejected, got %v", err)
+	}
+
+	// 签名私钥与同名公钥不一致时拒绝加载
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeKeyPair(t, dir, t.TempDir(), "k1", otherKey)
+	if err := signer.Keys.Reload(); err == nil {
+		t.Fatal("mismatched signing key should fail to reload")
+	}
+	if _, err := signer.CreateToken(testClaims()); err != nil {
+		t.Fatalf("failed reload should keep previous keys, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
+	"github.com/redis/go-redis/v9"
+)
+
+// revokedKeyPrefix 已吊销令牌在 Redis 中的键前缀，键为 {prefix}{jti}，已吊销会话为 {prefix}session:{sid}
+const revokedKeyPrefix = "jwt:revoked:"
+
+// sessionRevokeID 会话在吊销列表中的标识，与 jti 共用吊销列表
+func sessionRevokeID(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// Revoker 令牌吊销列表
+type Revoker interface {
+	// Revoke 吊销令牌直至 expiresAt，返回令牌此前是否未被吊销
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) (bool, error)
+	// IsRevoked 判断给定的标识中是否有任一已被吊销
+	IsRevoked(ctx context.Context, ids ...string) (bool, error)
+}
+
+// RedisRevoker 基于 Redis 的令牌吊销列表，记录在令牌过期后自动删除
+type RedisRevoker struct {
+	client redis.Cmdable
+}
+
+// NewRedisRevoker 使用 db 配置中的 Redis 创建令牌吊销列表
+func NewRedisRevoker() *RedisRevoker {
+	config := viper.Init("db")
+	return &RedisRevoker{
+		client: redis.NewClient(&redis.Options{
+			Addr:         fmt.Sprintf("%s:%s", config.Viper.GetString("redis.addr"), config.Viper.GetString("redis.port")),
+			Password:     config.Viper.GetString("redis.password"),
+			DB:           config.Viper.GetInt("redis.db"),
+			DialTimeout:  10 * time.Second,
+			ReadTimeout:  3 * time.Second,
+			WriteTimeout: 3 * time.Second,
+			PoolSize:     10,
+		}),
+	}
+}
+
+func (r *RedisRevoker) Revoke(ctx context.Context, jti string, expiresAt time.Time) (bool, error) {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// 已过期的令牌无法通过校验，无需记录
+		return true, nil
+	}
+	return r.client.SetNX(ctx, revokedKeyPrefix+jti, 1, ttl+time.Minute).Result()
+}
+
+func (r *RedisRevoker) IsRevoked(ctx context.Context, ids ...string) (bool, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = revokedKeyPrefix + id
+	}
+	n, err := r.client.Exists(ctx, keys...).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ClientIPFunc 返回获取客户端 IP 的函数，用于 engine.SetClientIPFunc。
+// 只有直接连接的地址属于 trustedProxies 时才读取 X-Forwarded-For 及 X-Real-IP，
+// 否则客户端可以伪造请求头，每次请求使用不同的 IP 绕过按 IP 的限流及登录限制。
+// trustedProxies 为 IP 或 CIDR，为空时始终使用连接的地址
+func ClientIPFunc(trustedProxies []string) (app.ClientIP, error) {
+	trusted, err := parseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return func(c *app.RequestContext) string {
+		return clientIP(c.RemoteAddr().String(), c.Request.Header.Get("X-Forwarded-For"), c.Request.Header.Get("X-Real-IP"), trusted)
+	}, nil
+}
+
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	trusted := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		trusted = append(trusted, ipNet)
+	}
+	return trusted, nil
+}
+
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	for _, ipNet := range trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP 连接地址为可信代理时，从右向左取 X-Forwarded-For 中第一个不可信的地址，
+// 全部可信时取最左侧的地址；没有 X-Forwarded-For 时使用 X-Real-IP
+func clientIP(remoteAddr, forwardedFor, realIP string, trusted []*net.IPNet) string {
+	remote := remoteAddr
+	if host, _, err := net.SplitHostPort(strings.TrimSpace(remoteAddr)); err == nil {
+		remote = host
+	}
+	if ip := net.ParseIP(remote); ip == nil || !isTrusted(ip, trusted) {
+		return remote
+	}
+
+	if forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			ip := net.ParseIP(hop)
+			if ip == nil {
+				// 无法解析的地址之前的各项均可能被伪造，退回使用连接的地址
+				return remote
+			}
+			if !isTrusted(ip, trusted) || i == 0 {
+				return ip.String()
+			}
+		}
+	}
+	if ip := net.ParseIP(strings.TrimSpace(realIP)); ip != nil {
+		return ip.String()
+	}
+	return remote
+}
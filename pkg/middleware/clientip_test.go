@@ -0,0 +1,37 @@
+package middleware
+
+import "testing"
+
+func TestClientIP(t *testing.T) {
+	trusted, err := parseTrustedProxies([]string{"10.0.0.0/8", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remote, forwardedFor, realIP string
+		want                         string
+	}{
+		// 直接连接的客户端伪造请求头无效
+		{"1.2.3.4:5678", "9.9.9.9", "8.8.8.8", "1.2.3.4"},
+		// 可信代理转发，取最右侧不可信的地址
+		{"10.0.0.2:80", "9.9.9.9, 5.6.7.8", "", "5.6.7.8"},
+		{"10.0.0.2:80", "5.6.7.8, 10.0.0.3", "", "5.6.7.8"},
+		{"127.0.0.1:80", "10.0.0.4, 10.0.0.3", "", "10.0.0.4"},
+		{"10.0.0.2:80", "", "5.6.7.8", "5.6.7.8"},
+		{"10.0.0.2:80", "", "", "10.0.0.2"},
+		{"10.0.0.2:80", "5.6.7.8, bad", "", "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		if got := clientIP(tt.remote, tt.forwardedFor, tt.realIP, trusted); got != tt.want {
+			t.Errorf("clientIP(%q, %q, %q) = %q, want %q", tt.remote, tt.forwardedFor, tt.realIP, got, tt.want)
+		}
+	}
+
+	// 未配置可信代理时始终使用连接的地址
+	if got := clientIP("1.2.3.4:5678", "9.9.9.9", "8.8.8.8", nil); got != "1.2.3.4" {
+		t.Errorf("clientIP without trusted proxies = %q", got)
+	}
+	if _, err := parseTrustedProxies([]string{"not-an-ip"}); err == nil {
+		t.Error("parseTrustedProxies accepted an invalid address")
+	}
+}
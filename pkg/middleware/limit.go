@@ -13,6 +13,10 @@ func TokenLimitMiddleware() app.HandlerFunc {
 
 	return func(ctx context.Context, c *app.RequestContext) {
 		token := c.GetString("Token")
+		if token == "" {
+			// 登录、注册等无需鉴权的接口按客户端 IP 限流，避免共用同一个令牌桶
+			token = "ip:" + c.ClientIP()
+		}
 
 		if !CurrentLimiter.Allow(token) {
 			responseWithError(ctx, c, http.StatusForbidden, "request too fast")
@@ -44,8 +44,8 @@ func runTestServer(middleware gin.HandlerFunc) {
 			return
 		}
 		token, err := userJwt.CreateToken(sjwt.CustomClaims{
-			int64(username),
-			gjwt.StandardClaims{
+			Id: int64(username),
+			StandardClaims: gjwt.StandardClaims{
 				ExpiresAt: time.Now().Add(time.Second * 5).Unix(), // 5秒之后失效
 				Issuer:    "dousheng",
 			},
@@ -0,0 +1,113 @@
+package minio
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+/*
+HLS 播放列表经 API 服务的 /hls 路由访问，返回给客户端的是带签名的主播放列表链接。
+API 服务校验签名后读取播放列表，将其中以相对路径引用的子播放列表替换为同样带签名的链接，
+分片替换为 MediaURL 生成的链接，HLS 文件因此无需允许匿名读取，与原视频一样仅返回给可以查看该视频的用户。
+*/
+
+// hlsPlaylistMaxSize 播放列表大小上限
+const hlsPlaylistMaxSize = 1 << 20
+
+var (
+	ErrNotPlaylist = errors.New("object is not a hls playlist")
+	hlsBaseURL     = minioConfig.Viper.GetString("minio.HLS.BaseURL")
+	hlsSignKey     = []byte(minioConfig.Viper.GetString("minio.HLS.SignKey"))
+)
+
+// isPlaylist 是否为 HLS 播放列表
+func isPlaylist(objectName string) bool {
+	return strings.EqualFold(path.Ext(objectName), ".m3u8")
+}
+
+// HLSPlaylistURL 生成经 API 服务访问 HLS 播放列表的签名链接，有效期与预签名链接一致
+func HLSPlaylistURL(bucketName, objectName string) (string, error) {
+	if len(bucketName) <= 0 || !isPlaylist(objectName) {
+		return "", ErrNotPlaylist
+	}
+	expires := time.Now().Add(time.Duration(ExpireTime) * time.Second).Unix()
+	return fmt.Sprintf("%s%s?expires=%d&signature=%s", strings.TrimSuffix(hlsBaseURL, "/"), objectPath(bucketName, objectName),
+		expires, signObject(hlsSignKey, bucketName, objectName, expires)), nil
+}
+
+// ServeHLSPlaylist 校验播放列表链接的签名，读取播放列表并将其中引用的子播放列表及分片替换为可访问的链接
+func ServeHLSPlaylist(bucketName, objectName, expires, signature string) ([]byte, error) {
+	if !isPlaylist(objectName) {
+		return nil, ErrNotPlaylist
+	}
+	if err := verifyObjectSignature(hlsSignKey, bucketName, objectName, expires, signature); err != nil {
+		return nil, err
+	}
+	reader, err := GetFile(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	playlist, err := io.ReadAll(io.LimitReader(reader, hlsPlaylistMaxSize))
+	if err != nil {
+		return nil, err
+	}
+	dir := path.Dir(objectName)
+	return rewritePlaylist(playlist, func(uri string) (string, error) {
+		name := path.Join(dir, uri)
+		if isPlaylist(name) {
+			return HLSPlaylistURL(bucketName, name)
+		}
+		return MediaURL(bucketName, name)
+	})
+}
+
+// rewritePlaylist 将播放列表中以相对路径引用的 URI 替换为 resolve 返回的链接，包括 URI 行及标签中的 URI 属性，
+// 绝对路径及完整链接保持不变
+func rewritePlaylist(playlist []byte, resolve func(uri string) (string, error)) ([]byte, error) {
+	rewrite := func(uri string) (string, error) {
+		if uri == "" || strings.HasPrefix(uri, "/") || strings.Contains(uri, "://") {
+			return uri, nil
+		}
+		return resolve(uri)
+	}
+
+	var buf bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(playlist))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#"):
+			// 如 #EXT-X-MAP:URI="init.mp4"、#EXT-X-MEDIA:...,URI="audio/index.m3u8"
+			if i := strings.Index(line, `URI="`); i >= 0 {
+				start := i + len(`URI="`)
+				if end := strings.IndexByte(line[start:], '"'); end >= 0 {
+					uri, err := rewrite(line[start : start+end])
+					if err != nil {
+						return nil, err
+					}
+					line = line[:start] + uri + line[start+end:]
+				}
+			}
+		default:
+			uri, err := rewrite(line)
+			if err != nil {
+				return nil, err
+			}
+			line = uri
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
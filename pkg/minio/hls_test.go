@@ -0,0 +1,32 @@
+package minio
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRewritePlaylist(t *testing.T) {
+	playlist := "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=800000\n720p/index.m3u8\n\n" +
+		"#EXT-X-MAP:URI=\"init.mp4\"\n#EXTINF:6.0,\nseg_000.ts\nhttps://cdn.example.com/seg_001.ts\n"
+	got, err := rewritePlaylist([]byte(playlist), func(uri string) (string, error) {
+		return "https://signed/" + uri + "?s=1", nil
+	})
+	ExpectEqual(err, nil, t)
+	want := "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=800000\nhttps://signed/720p/index.m3u8?s=1\n\n" +
+		"#EXT-X-MAP:URI=\"https://signed/init.mp4?s=1\"\n#EXTINF:6.0,\nhttps://signed/seg_000.ts?s=1\nhttps://cdn.example.com/seg_001.ts\n"
+	ExpectEqual(string(got), want, t)
+}
+
+func TestVerifyObjectSignature(t *testing.T) {
+	key := []byte("key")
+	expires := time.Now().Add(time.Minute).Unix()
+	signature := signObject(key, "bucket", "hls/1/a/master.m3u8", expires)
+	ExpectEqual(verifyObjectSignature(key, "bucket", "hls/1/a/master.m3u8", strconv.FormatInt(expires, 10), signature), nil, t)
+	ExpectEqual(verifyObjectSignature(key, "bucket", "hls/1/b/master.m3u8", strconv.FormatInt(expires, 10), signature), ErrInvalidSignature, t)
+	ExpectEqual(verifyObjectSignature([]byte("other"), "bucket", "hls/1/a/master.m3u8", strconv.FormatInt(expires, 10), signature), ErrInvalidSignature, t)
+
+	expired := time.Now().Add(-time.Minute).Unix()
+	signature = signObject(key, "bucket", "hls/1/a/master.m3u8", expired)
+	ExpectEqual(verifyObjectSignature(key, "bucket", "hls/1/a/master.m3u8", strconv.FormatInt(expired, 10), signature), ErrSignatureExpired, t)
+}
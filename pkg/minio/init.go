@@ -1,14 +1,16 @@
 package minio
 
 import (
+	"time"
+
 	"github.com/bytedance-youthcamp-jbzx/tiktok/pkg/viper"
 
 	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 var (
 	minioClient               *minio.Client
+	minioCore                 *minio.Core
 	minioConfig               = viper.Init("minio")
 	MinioEndPoint             = minioConfig.Viper.GetString("minio.Endpoint")
 	MinioAccessKeyId          = minioConfig.Viper.GetString("minio.AccessKeyId")
@@ -19,21 +21,44 @@ var (
 	AvatarBucketName          = minioConfig.Viper.GetString("minio.AvatarBucketName")
 	BackgroundImageBucketName = minioConfig.Viper.GetString("minio.BackgroundImageBucketName")
 	ExpireTime                = minioConfig.Viper.GetUint32("minio.ExpireTime")
+	Backend                   = minioConfig.Viper.GetString("minio.Backend")
+	LocalRoot                 = minioConfig.Viper.GetString("minio.Local.Root")
+	LocalBaseURL              = minioConfig.Viper.GetString("minio.Local.BaseURL")
+	PublicBaseURL             = publicBaseURL()
+	mediaURLResolver          MediaURLResolver
+	store                     ObjectStore
 )
 
-func init() {
-	s3client, err := minio.New(MinioEndPoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(MinioAccessKeyId, MinioSecretAccessKey, ""),
-		Secure: UseSSL,
-	})
+// publicBaseURL 公共读链接的前缀，未配置时使用 Minio 地址，本地存储使用 API 服务的地址
+func publicBaseURL() string {
+	if u := minioConfig.Viper.GetString("minio.PublicBaseURL"); u != "" {
+		return u
+	}
+	if Backend == BackendLocal {
+		return LocalBaseURL
+	}
+	if UseSSL {
+		return "https://" + MinioEndPoint
+	}
+	return "http://" + MinioEndPoint
+}
 
+// init 仅创建对象存储客户端，不会连接 Minio，存储桶由各服务启动时创建
+func init() {
+	s, err := NewObjectStore(Backend)
 	if err != nil {
 		panic(err)
 	}
+	store = s
+	if ms, ok := s.(*MinioStore); ok {
+		minioClient = ms.client
+		minioCore = ms.core
+	}
 
-	minioClient = s3client
-
-	if err := CreateBucket(VideoBucketName); err != nil {
+	resolver, err := NewMediaURLResolver(minioConfig.Viper.GetString("minio.URLStrategy"))
+	if err != nil {
 		panic(err)
 	}
+	refreshBefore := time.Duration(minioConfig.Viper.GetUint32("minio.URLRefreshBefore")) * time.Second
+	mediaURLResolver = NewCachedResolver(resolver, refreshBefore, 100000)
 }
@@ -0,0 +1,430 @@
+package minio
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	localMultipartDir   = ".multipart"   // 分片上传会话目录，位于 Root 下
+	localPolicyFile     = ".public-read" // 存储桶内允许匿名读取的前缀，每行一个
+	localMetaFile       = "meta"         // 分片上传会话对应的存储桶及对象
+	localPartETagSuffix = ".etag"
+)
+
+var (
+	ErrInvalidObjectName = errors.New("invalid object name")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrSignatureExpired  = errors.New("signature expired")
+	ErrNotLocalStore     = errors.New("storage backend is not local")
+)
+
+// LocalStore 基于本地磁盘的对象存储，对象保存在 {Root}/{bucket}/{object}，
+// 访问链接指向 API 服务的 /storage 路由，由 ServeLocalFile 校验签名或匿名读取权限后返回文件
+type LocalStore struct {
+	Root    string
+	BaseURL string
+	SignKey []byte
+}
+
+// NewLocalStore 创建本地存储并确保根目录存在
+func NewLocalStore(root, baseURL string, signKey []byte) (*LocalStore, error) {
+	if len(root) <= 0 {
+		return nil, errors.New("local storage root is empty")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{Root: root, BaseURL: baseURL, SignKey: signKey}, nil
+}
+
+// objectFile 返回对象在磁盘上的路径，拒绝越出存储桶目录的对象名
+func (s *LocalStore) objectFile(bucketName, objectName string) (string, error) {
+	if len(bucketName) <= 0 || strings.ContainsAny(bucketName, `/\`) || strings.HasPrefix(bucketName, ".") {
+		return "", ErrInvalidObjectName
+	}
+	if len(objectName) <= 0 || strings.HasPrefix(objectName, "/") || strings.Contains(objectName, `\`) ||
+		path.Clean(objectName) != objectName || objectName == ".." || strings.HasPrefix(objectName, "../") ||
+		path.Base(objectName) == localPolicyFile {
+		return "", ErrInvalidObjectName
+	}
+	return filepath.Join(s.Root, bucketName, filepath.FromSlash(objectName)), nil
+}
+
+// writeFile 先写入同目录下的临时文件再重命名，避免读取到写了一半的对象
+func writeFile(name string, reader io.Reader, size int64) (int64, string, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return -1, "", err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return -1, "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+	hash := md5.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), reader)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return -1, "", err
+	}
+	if size >= 0 && n != size {
+		return -1, "", io.ErrUnexpectedEOF
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return -1, "", err
+	}
+	return n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (s *LocalStore) CreateBucket(_ context.Context, bucketName string) error {
+	if strings.ContainsAny(bucketName, `/\`) || strings.HasPrefix(bucketName, ".") {
+		return errors.New("bucketName invalid")
+	}
+	return os.MkdirAll(filepath.Join(s.Root, bucketName), 0o755)
+}
+
+func (s *LocalStore) Put(_ context.Context, bucketName, objectName string, reader io.Reader, size int64, _ string) (int64, error) {
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return -1, err
+	}
+	n, _, err := writeFile(name, reader, size)
+	return n, err
+}
+
+func (s *LocalStore) PutFile(ctx context.Context, bucketName, objectName, path, contentType string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return -1, err
+	}
+	defer file.Close()
+	return s.Put(ctx, bucketName, objectName, file, -1, contentType)
+}
+
+func (s *LocalStore) Get(_ context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(name)
+}
+
+func (s *LocalStore) Stat(_ context.Context, bucketName, objectName string) (*ObjectInfo, error) {
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fs.ErrNotExist
+	}
+	return &ObjectInfo{
+		Size:         info.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(objectName)),
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+func (s *LocalStore) Remove(_ context.Context, bucketName, objectName string) error {
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) RemovePrefix(_ context.Context, bucketName, prefix string) error {
+	bucketDir := filepath.Join(s.Root, bucketName)
+	err := filepath.WalkDir(bucketDir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(bucketDir, name)
+		if err != nil {
+			return err
+		}
+		objectName := filepath.ToSlash(rel)
+		if objectName == localPolicyFile || !strings.HasPrefix(objectName, prefix) {
+			return nil
+		}
+		return os.Remove(name)
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// signObject 计算对象链接的签名
+func signObject(key []byte, bucketName, objectName string, expires int64) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(fmt.Sprintf("%s/%s:%d", bucketName, objectName, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyObjectSignature 校验对象链接的签名及有效期
+func verifyObjectSignature(key []byte, bucketName, objectName, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(signObject(key, bucketName, objectName, expiresAt))) {
+		return ErrInvalidSignature
+	}
+	if time.Now().Unix() > expiresAt {
+		return ErrSignatureExpired
+	}
+	return nil
+}
+
+// sign 计算对象链接的签名
+func (s *LocalStore) sign(bucketName, objectName string, expires int64) string {
+	return signObject(s.SignKey, bucketName, objectName, expires)
+}
+
+func (s *LocalStore) PresignGet(_ context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	if _, err := s.objectFile(bucketName, objectName); err != nil {
+		return "", err
+	}
+	expires := time.Now().Add(expiry).Unix()
+	return fmt.Sprintf("%s%s?expires=%d&signature=%s", strings.TrimSuffix(s.BaseURL, "/"), objectPath(bucketName, objectName),
+		expires, s.sign(bucketName, objectName, expires)), nil
+}
+
+// publicPrefixes 读取存储桶内允许匿名读取的前缀
+func (s *LocalStore) publicPrefixes(bucketName string) ([]string, error) {
+	file, err := os.Open(filepath.Join(s.Root, bucketName, localPolicyFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	prefixes := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			prefixes = append(prefixes, line)
+		}
+	}
+	return prefixes, scanner.Err()
+}
+
+func (s *LocalStore) SetPublicReadPrefix(_ context.Context, bucketName, prefix string) error {
+	prefixes, err := s.publicPrefixes(bucketName)
+	if err != nil {
+		return err
+	}
+	for _, p := range prefixes {
+		if p == prefix {
+			return nil
+		}
+	}
+	prefixes = append(prefixes, prefix)
+	_, _, err = writeFile(filepath.Join(s.Root, bucketName, localPolicyFile), strings.NewReader(strings.Join(prefixes, "\n")+"\n"), -1)
+	return err
+}
+
+func (s *LocalStore) RemovePublicReadPrefix(_ context.Context, bucketName, prefix string) error {
+	prefixes, err := s.publicPrefixes(bucketName)
+	if err != nil {
+		return err
+	}
+	remain := make([]string, 0, len(prefixes))
+	for _, p := range prefixes {
+		if p != prefix {
+			remain = append(remain, p)
+		}
+	}
+	if len(remain) == len(prefixes) {
+		return nil
+	}
+	name := filepath.Join(s.Root, bucketName, localPolicyFile)
+	if len(remain) == 0 {
+		return os.Remove(name)
+	}
+	_, _, err = writeFile(name, strings.NewReader(strings.Join(remain, "\n")+"\n"), -1)
+	return err
+}
+
+// Verify 校验对象链接，允许匿名读取的对象无需签名，返回对象在磁盘上的路径
+func (s *LocalStore) Verify(bucketName, objectName, expires, signature string) (string, error) {
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	prefixes, err := s.publicPrefixes(bucketName)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(objectName, p) {
+			return name, nil
+		}
+	}
+
+	if err := verifyObjectSignature(s.SignKey, bucketName, objectName, expires, signature); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// uploadDir 返回分片上传会话目录，并校验会话属于给定对象
+func (s *LocalStore) uploadDir(bucketName, objectName, uploadID string) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", errors.New("invalid uploadID")
+	}
+	dir := filepath.Join(s.Root, localMultipartDir, uploadID)
+	meta, err := os.ReadFile(filepath.Join(dir, localMetaFile))
+	if err != nil {
+		return "", err
+	}
+	if string(meta) != bucketName+"/"+objectName {
+		return "", errors.New("uploadID does not match object")
+	}
+	return dir, nil
+}
+
+func (s *LocalStore) NewMultipartUpload(_ context.Context, bucketName, objectName, _ string) (string, error) {
+	if _, err := s.objectFile(bucketName, objectName); err != nil {
+		return "", err
+	}
+	uploadID := uuid.New().String()
+	dir := filepath.Join(s.Root, localMultipartDir, uploadID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, localMetaFile), []byte(bucketName+"/"+objectName), 0o644); err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+func (s *LocalStore) UploadPart(_ context.Context, bucketName, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (string, error) {
+	dir, err := s.uploadDir(bucketName, objectName, uploadID)
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(dir, fmt.Sprintf("%05d", partNumber))
+	_, etag, err := writeFile(name, reader, size)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(name+localPartETagSuffix, []byte(etag), 0o644); err != nil {
+		return "", err
+	}
+	return etag, nil
+}
+
+func (s *LocalStore) ListParts(_ context.Context, bucketName, objectName, uploadID string) ([]ObjectPart, error) {
+	dir, err := s.uploadDir(bucketName, objectName, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]ObjectPart, 0)
+	for _, entry := range entries {
+		partNumber, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		etag, err := os.ReadFile(filepath.Join(dir, entry.Name()+localPartETagSuffix))
+		if err != nil {
+			// 分片已写入但 ETag 尚未写入，视为未上传
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, ObjectPart{PartNumber: partNumber, ETag: string(etag), Size: info.Size()})
+	}
+	return parts, nil
+}
+
+func (s *LocalStore) CompleteMultipartUpload(_ context.Context, bucketName, objectName, uploadID string, parts []ObjectPart) error {
+	dir, err := s.uploadDir(bucketName, objectName, uploadID)
+	if err != nil {
+		return err
+	}
+	name, err := s.objectFile(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]ObjectPart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].PartNumber < sorted[j].PartNumber
+	})
+	readers := make([]io.Reader, 0, len(sorted))
+	for _, part := range sorted {
+		file, err := os.Open(filepath.Join(dir, fmt.Sprintf("%05d", part.PartNumber)))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		readers = append(readers, file)
+	}
+	if _, _, err := writeFile(name, io.MultiReader(readers...), -1); err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+func (s *LocalStore) AbortMultipartUpload(_ context.Context, bucketName, objectName, uploadID string) error {
+	dir, err := s.uploadDir(bucketName, objectName, uploadID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// ServeLocalFile 校验本地存储的对象链接，返回对象在磁盘上的路径，供 API 服务返回文件
+func ServeLocalFile(bucketName, objectName, expires, signature string) (string, error) {
+	local, ok := store.(*LocalStore)
+	if !ok {
+		return "", ErrNotLocalStore
+	}
+	return local.Verify(bucketName, objectName, expires, signature)
+}
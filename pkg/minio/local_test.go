@@ -0,0 +1,123 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLocalStore(t *testing.T) *LocalStore {
+	s, err := NewLocalStore(t.TempDir(), "http://127.0.0.1:8089/storage", []byte("key"))
+	ExpectEqual(err, nil, t)
+	ExpectEqual(s.CreateBucket(context.Background(), "bucket"), nil, t)
+	return s
+}
+
+func TestLocalStorePutGet(t *testing.T) {
+	s := newTestLocalStore(t)
+	ctx := context.Background()
+
+	n, err := s.Put(ctx, "bucket", "1/a.mp4", strings.NewReader("video"), -1, "video/mp4")
+	ExpectEqual(err, nil, t)
+	ExpectEqual(n, int64(5), t)
+
+	info, err := s.Stat(ctx, "bucket", "1/a.mp4")
+	ExpectEqual(err, nil, t)
+	ExpectEqual(info.Size, int64(5), t)
+	ExpectEqual(info.ContentType, "video/mp4", t)
+
+	r, err := s.Get(ctx, "bucket", "1/a.mp4")
+	ExpectEqual(err, nil, t)
+	data, _ := io.ReadAll(r)
+	r.Close()
+	ExpectEqual(string(data), "video", t)
+
+	_, err = s.Put(ctx, "bucket", "1/b.mp4", strings.NewReader("video"), 10, "video/mp4")
+	ExpectUnEqual(err, nil, t)
+
+	ExpectEqual(s.RemovePrefix(ctx, "bucket", "1/"), nil, t)
+	_, err = s.Stat(ctx, "bucket", "1/a.mp4")
+	ExpectUnEqual(err, nil, t)
+	ExpectEqual(s.Remove(ctx, "bucket", "1/a.mp4"), nil, t)
+}
+
+func TestLocalStoreInvalidObjectName(t *testing.T) {
+	s := newTestLocalStore(t)
+	for _, name := range []string{"", "../a", "a/../../b", "/a", "a//b", ".public-read"} {
+		_, err := s.Put(context.Background(), "bucket", name, strings.NewReader("x"), -1, "")
+		ExpectEqual(err, ErrInvalidObjectName, t)
+	}
+	_, err := s.Put(context.Background(), "..", "a", strings.NewReader("x"), -1, "")
+	ExpectEqual(err, ErrInvalidObjectName, t)
+}
+
+func TestLocalStoreMultipart(t *testing.T) {
+	s := newTestLocalStore(t)
+	ctx := context.Background()
+
+	uploadID, err := s.NewMultipartUpload(ctx, "bucket", "1/c.mp4", "video/mp4")
+	ExpectEqual(err, nil, t)
+	_, err = s.UploadPart(ctx, "bucket", "1/c.mp4", uploadID, 2, strings.NewReader("world"), 5)
+	ExpectEqual(err, nil, t)
+	_, err = s.UploadPart(ctx, "bucket", "1/c.mp4", uploadID, 1, strings.NewReader("hello "), 6)
+	ExpectEqual(err, nil, t)
+	_, err = s.UploadPart(ctx, "bucket", "1/d.mp4", uploadID, 3, strings.NewReader("x"), 1)
+	ExpectUnEqual(err, nil, t)
+
+	parts, err := s.ListParts(ctx, "bucket", "1/c.mp4", uploadID)
+	ExpectEqual(err, nil, t)
+	ExpectEqual(len(parts), 2, t)
+
+	ExpectEqual(s.CompleteMultipartUpload(ctx, "bucket", "1/c.mp4", uploadID, parts), nil, t)
+	r, err := s.Get(ctx, "bucket", "1/c.mp4")
+	ExpectEqual(err, nil, t)
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	r.Close()
+	ExpectEqual(buf.String(), "hello world", t)
+
+	_, err = s.ListParts(ctx, "bucket", "1/c.mp4", uploadID)
+	ExpectUnEqual(err, nil, t)
+}
+
+func TestLocalStoreVerify(t *testing.T) {
+	s := newTestLocalStore(t)
+	ctx := context.Background()
+
+	raw, err := s.PresignGet(ctx, "bucket", "1/a b.mp4", time.Minute)
+	ExpectEqual(err, nil, t)
+	u, err := url.Parse(raw)
+	ExpectEqual(err, nil, t)
+	ExpectEqual(u.Path, "/storage/bucket/1/a b.mp4", t)
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	_, err = s.Verify("bucket", "1/a b.mp4", expires, signature)
+	ExpectEqual(err, nil, t)
+	_, err = s.Verify("bucket", "1/other.mp4", expires, signature)
+	ExpectEqual(err, ErrInvalidSignature, t)
+	_, err = s.Verify("bucket", "1/a b.mp4", "1", s.sign("bucket", "1/a b.mp4", 1))
+	ExpectEqual(err, ErrSignatureExpired, t)
+
+	_, err = s.Verify("bucket", "hls/1/index.m3u8", "", "")
+	ExpectEqual(err, ErrInvalidSignature, t)
+	ExpectEqual(s.SetPublicReadPrefix(ctx, "bucket", "hls/"), nil, t)
+	ExpectEqual(s.SetPublicReadPrefix(ctx, "bucket", "hls/"), nil, t)
+	_, err = s.Verify("bucket", "hls/1/index.m3u8", "", "")
+	ExpectEqual(err, nil, t)
+
+	// 取消后恢复为需要签名，重复取消不报错
+	ExpectEqual(s.SetPublicReadPrefix(ctx, "bucket", "cover/"), nil, t)
+	ExpectEqual(s.RemovePublicReadPrefix(ctx, "bucket", "hls/"), nil, t)
+	ExpectEqual(s.RemovePublicReadPrefix(ctx, "bucket", "hls/"), nil, t)
+	_, err = s.Verify("bucket", "hls/1/index.m3u8", "", "")
+	ExpectEqual(err, ErrInvalidSignature, t)
+	_, err = s.Verify("bucket", "cover/1.jpg", "", "")
+	ExpectEqual(err, nil, t)
+	ExpectEqual(s.RemovePublicReadPrefix(ctx, "bucket", "cover/"), nil, t)
+	_, err = s.Verify("bucket", "cover/1.jpg", "", "")
+	ExpectEqual(err, ErrInvalidSignature, t)
+}
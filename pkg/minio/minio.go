@@ -5,25 +5,13 @@ import (
 	"errors"
 	"io"
 	"time"
-
-	"github.com/minio/minio-go/v7"
 )
 
 func CreateBucket(bucketName string) error {
 	if len(bucketName) <= 0 {
 		return errors.New("bucketName invalid")
 	}
-	ctx := context.Background()
-	if err := minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{}); err != nil {
-		exists, errEx := minioClient.BucketExists(ctx, bucketName)
-		if exists && errEx != nil {
-			// nothing
-		} else {
-			return errEx
-		}
-
-	}
-	return nil
+	return store.CreateBucket(context.Background(), bucketName)
 }
 
 func UploadFileByPath(bucketName, objectName, path, contentType string) (int64, error) {
@@ -31,46 +19,145 @@ func UploadFileByPath(bucketName, objectName, path, contentType string) (int64,
 		return -1, errors.New("invalid argument")
 	}
 
-	uploadInfo, err := minioClient.FPutObject(context.Background(), bucketName, objectName, path, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	return store.PutFile(context.Background(), bucketName, objectName, path, contentType)
+}
 
-	if err != nil {
-		return -1, err
+func UploadFileByIO(bucketName, objectName string, reader io.Reader, size int64, contentType string) (int64, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return -1, errors.New("invalid argument")
 	}
 
-	return uploadInfo.Size, nil
+	return store.Put(context.Background(), bucketName, objectName, reader, size, contentType)
 }
 
-func UploadFileByIO(bucketName, objectName string, reader io.Reader, size int64, contentType string) (int64, error) {
+// GetFile 读取存储桶中的对象，调用方负责关闭
+func GetFile(bucketName, objectName string) (io.ReadCloser, error) {
 	if len(bucketName) <= 0 || len(objectName) <= 0 {
-		return -1, errors.New("invalid argument")
+		return nil, errors.New("invalid argument")
 	}
 
-	uploadInfo, err := minioClient.PutObject(context.Background(), bucketName, objectName, reader, size, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	return store.Get(context.Background(), bucketName, objectName)
+}
 
-	if err != nil {
-		return -1, err
+// StatFile 获取存储桶中对象的元信息
+func StatFile(bucketName, objectName string) (*ObjectInfo, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return nil, errors.New("invalid argument")
 	}
 
-	return uploadInfo.Size, nil
+	return store.Stat(context.Background(), bucketName, objectName)
 }
 
+// GetFileTemporaryURL 获取对象的预签名链接，用于服务内部读取对象，返回给客户端的链接使用 MediaURL
 func GetFileTemporaryURL(bucketName, objectName string) (string, error) {
+	return presignedURL(bucketName, objectName, time.Second*time.Duration(ExpireTime))
+}
+
+// presignedURL 生成指定有效期的预签名链接
+func presignedURL(bucketName, objectName string, expiry time.Duration) (string, error) {
 	if len(bucketName) <= 0 || len(objectName) <= 0 {
 		return "", errors.New("invalid argument")
 	}
 
-	expiry := time.Second * time.Duration(ExpireTime)
+	return store.PresignGet(context.Background(), bucketName, objectName, expiry)
+}
+
+// RemoveFile 删除存储桶中的对象
+func RemoveFile(bucketName, objectName string) error {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return errors.New("invalid argument")
+	}
+
+	return store.Remove(context.Background(), bucketName, objectName)
+}
 
-	presignedURL, err := minioClient.PresignedGetObject(context.Background(), bucketName, objectName, expiry, nil)
+// RemoveFilesByPrefix 删除存储桶中指定前缀下的全部对象
+func RemoveFilesByPrefix(bucketName, prefix string) error {
+	if len(bucketName) <= 0 || len(prefix) <= 0 {
+		return errors.New("invalid argument")
+	}
 
+	return store.RemovePrefix(context.Background(), bucketName, prefix)
+}
+
+// NewMultipartUpload 创建分片上传会话，返回 uploadID
+func NewMultipartUpload(bucketName, objectName, contentType string) (string, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return "", errors.New("invalid argument")
+	}
+
+	return store.NewMultipartUpload(context.Background(), bucketName, objectName, contentType)
+}
+
+// UploadPart 上传单个分片，返回该分片的 ETag
+func UploadPart(bucketName, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (string, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 || len(uploadID) <= 0 || partNumber <= 0 {
+		return "", errors.New("invalid argument")
+	}
+
+	return store.UploadPart(context.Background(), bucketName, objectName, uploadID, partNumber, reader, size)
+}
+
+// ListUploadedParts 获取分片上传会话中已上传的全部分片
+func ListUploadedParts(bucketName, objectName, uploadID string) ([]ObjectPart, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 || len(uploadID) <= 0 {
+		return nil, errors.New("invalid argument")
+	}
+
+	return store.ListParts(context.Background(), bucketName, objectName, uploadID)
+}
+
+// CompleteMultipartUpload 按分片序号合并已上传的分片，返回合并后的文件大小
+func CompleteMultipartUpload(bucketName, objectName, uploadID string) (int64, error) {
+	parts, err := ListUploadedParts(bucketName, objectName, uploadID)
 	if err != nil {
-		return "", err
+		return -1, err
+	}
+	if len(parts) == 0 {
+		return -1, errors.New("no part uploaded")
+	}
+
+	var size int64
+	for _, part := range parts {
+		size += part.Size
+	}
+
+	if err := store.CompleteMultipartUpload(context.Background(), bucketName, objectName, uploadID, parts); err != nil {
+		return -1, err
+	}
+
+	return size, nil
+}
+
+// AbortMultipartUpload 取消分片上传会话，并清理已上传的分片
+func AbortMultipartUpload(bucketName, objectName, uploadID string) error {
+	if len(bucketName) <= 0 || len(objectName) <= 0 || len(uploadID) <= 0 {
+		return errors.New("invalid argument")
 	}
 
-	return presignedURL.String(), nil
+	return store.AbortMultipartUpload(context.Background(), bucketName, objectName, uploadID)
+}
+
+// SetBucketPublicReadPrefix 允许匿名读取存储桶中指定前缀下的对象
+func SetBucketPublicReadPrefix(bucketName, prefix string) error {
+	if len(bucketName) <= 0 || len(prefix) <= 0 {
+		return errors.New("invalid argument")
+	}
+
+	return store.SetPublicReadPrefix(context.Background(), bucketName, prefix)
+}
+
+// RemoveBucketPublicReadPrefix 取消存储桶中指定前缀的匿名读取，未设置时不报错
+func RemoveBucketPublicReadPrefix(bucketName, prefix string) error {
+	if len(bucketName) <= 0 || len(prefix) <= 0 {
+		return errors.New("invalid argument")
+	}
+
+	return store.RemovePublicReadPrefix(context.Background(), bucketName, prefix)
+}
 
+// GetFilePublicURL 获取对象的永久访问链接，仅对允许匿名读取的对象有效，链接前缀可配置为 CDN 地址
+func GetFilePublicURL(bucketName, objectName string) (string, error) {
+	u, _, err := (&PublicResolver{BaseURL: PublicBaseURL}).Resolve(bucketName, objectName)
+	return u, err
 }
@@ -0,0 +1,243 @@
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// MinioStore 基于 Minio 的对象存储
+type MinioStore struct {
+	client *minio.Client
+	core   *minio.Core
+}
+
+// NewMinioStore 创建 Minio 客户端，此时不会连接 Minio
+func NewMinioStore(endpoint, accessKeyID, secretAccessKey string, useSSL bool) (*MinioStore, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MinioStore{client: client, core: &minio.Core{Client: client}}, nil
+}
+
+func (s *MinioStore) CreateBucket(ctx context.Context, bucketName string) error {
+	if err := s.client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{}); err != nil {
+		exists, errEx := s.client.BucketExists(ctx, bucketName)
+		if errEx != nil {
+			return errEx
+		}
+		if !exists {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MinioStore) Put(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, contentType string) (int64, error) {
+	uploadInfo, err := s.client.PutObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return -1, err
+	}
+	return uploadInfo.Size, nil
+}
+
+func (s *MinioStore) PutFile(ctx context.Context, bucketName, objectName, path, contentType string) (int64, error) {
+	uploadInfo, err := s.client.FPutObject(ctx, bucketName, objectName, path, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return -1, err
+	}
+	return uploadInfo.Size, nil
+}
+
+func (s *MinioStore) Get(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject 不会发起请求，先读取元信息以便对象不存在时立即返回错误
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
+
+func (s *MinioStore) Stat(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (s *MinioStore) Remove(ctx context.Context, bucketName, objectName string) error {
+	return s.client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
+}
+
+func (s *MinioStore) RemovePrefix(ctx context.Context, bucketName, prefix string) error {
+	objectsCh := s.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	// 需读完结果通道，避免删除协程阻塞
+	var err error
+	for e := range s.client.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if e.Err != nil {
+			err = e.Err
+		}
+	}
+	return err
+}
+
+func (s *MinioStore) PresignGet(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, bucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *MinioStore) SetPublicReadPrefix(ctx context.Context, bucketName, prefix string) error {
+	policy := fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::%s/%s*"]}]}`, bucketName, prefix)
+	return s.client.SetBucketPolicy(ctx, bucketName, policy)
+}
+
+func (s *MinioStore) RemovePublicReadPrefix(ctx context.Context, bucketName, prefix string) error {
+	policy, err := s.client.GetBucketPolicy(ctx, bucketName)
+	if err != nil || policy == "" {
+		return err
+	}
+	policy, changed, err := removePublicReadResource(policy, "arn:aws:s3:::"+bucketName+"/"+prefix+"*")
+	if err != nil || !changed {
+		return err
+	}
+	// 策略为空时删除存储桶策略
+	return s.client.SetBucketPolicy(ctx, bucketName, policy)
+}
+
+// removePublicReadResource 从存储桶策略的允许语句中移除指定资源，移除后没有资源的语句一并删除，
+// 没有语句时返回空字符串
+func removePublicReadResource(policy, resource string) (string, bool, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return "", false, err
+	}
+	var statements []map[string]json.RawMessage
+	if err := json.Unmarshal(doc["Statement"], &statements); err != nil {
+		return "", false, err
+	}
+
+	changed := false
+	kept := make([]map[string]json.RawMessage, 0, len(statements))
+	for _, statement := range statements {
+		var effect string
+		_ = json.Unmarshal(statement["Effect"], &effect)
+		// Resource 可以是字符串或数组
+		var resources []string
+		if err := json.Unmarshal(statement["Resource"], &resources); err != nil {
+			var r string
+			if json.Unmarshal(statement["Resource"], &r) != nil {
+				kept = append(kept, statement)
+				continue
+			}
+			resources = []string{r}
+		}
+		if effect != "Allow" {
+			kept = append(kept, statement)
+			continue
+		}
+
+		remain := make([]string, 0, len(resources))
+		for _, r := range resources {
+			if r != resource {
+				remain = append(remain, r)
+			}
+		}
+		if len(remain) == len(resources) {
+			kept = append(kept, statement)
+			continue
+		}
+		changed = true
+		if len(remain) > 0 {
+			statement["Resource"], _ = json.Marshal(remain)
+			kept = append(kept, statement)
+		}
+	}
+	if !changed {
+		return policy, false, nil
+	}
+	if len(kept) == 0 {
+		return "", true, nil
+	}
+	doc["Statement"], _ = json.Marshal(kept)
+	data, err := json.Marshal(doc)
+	return string(data), true, err
+}
+
+func (s *MinioStore) NewMultipartUpload(ctx context.Context, bucketName, objectName, contentType string) (string, error) {
+	return s.core.NewMultipartUpload(ctx, bucketName, objectName, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+}
+
+func (s *MinioStore) UploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (string, error) {
+	part, err := s.core.PutObjectPart(ctx, bucketName, objectName, uploadID, partNumber, reader, size, "", "", nil)
+	if err != nil {
+		return "", err
+	}
+	return part.ETag, nil
+}
+
+func (s *MinioStore) ListParts(ctx context.Context, bucketName, objectName, uploadID string) ([]ObjectPart, error) {
+	parts := make([]ObjectPart, 0)
+	marker := 0
+	for {
+		result, err := s.core.ListObjectParts(ctx, bucketName, objectName, uploadID, marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range result.ObjectParts {
+			parts = append(parts, ObjectPart{PartNumber: part.PartNumber, ETag: part.ETag, Size: part.Size})
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextPartNumberMarker
+	}
+	return parts, nil
+}
+
+func (s *MinioStore) CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []ObjectPart) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		})
+	}
+	sort.Slice(completeParts, func(i, j int) bool {
+		return completeParts[i].PartNumber < completeParts[j].PartNumber
+	})
+	_, err := s.core.CompleteMultipartUpload(ctx, bucketName, objectName, uploadID, completeParts, minio.PutObjectOptions{})
+	return err
+}
+
+func (s *MinioStore) AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	return s.core.AbortMultipartUpload(ctx, bucketName, objectName, uploadID)
+}
@@ -0,0 +1,36 @@
+package minio
+
+import "testing"
+
+func TestRemovePublicReadResource(t *testing.T) {
+	const resource = "arn:aws:s3:::video/hls/*"
+	tests := []struct {
+		policy  string
+		want    string
+		changed bool
+	}{
+		// 旧版本设置的策略只有一条语句，移除后删除整个策略
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::video/hls/*"]}]}`, "", true},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::video/hls/*"}]}`, "", true},
+		// 保留其他资源及语句
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":["arn:aws:s3:::video/hls/*","arn:aws:s3:::video/cover/*"]}]}`,
+			`{"Statement":[{"Effect":"Allow","Resource":["arn:aws:s3:::video/cover/*"]}],"Version":"2012-10-17"}`, true},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":["arn:aws:s3:::video/hls/*"]},{"Effect":"Allow","Resource":["arn:aws:s3:::video/cover/*"]}]}`,
+			`{"Statement":[{"Effect":"Allow","Resource":["arn:aws:s3:::video/cover/*"]}],"Version":"2012-10-17"}`, true},
+		// 没有对应的允许语句时不修改
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Resource":["arn:aws:s3:::video/hls/*"]}]}`,
+			`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Resource":["arn:aws:s3:::video/hls/*"]}]}`, false},
+	}
+	for _, tt := range tests {
+		got, changed, err := removePublicReadResource(tt.policy, resource)
+		if err != nil {
+			t.Fatalf("removePublicReadResource(%s): %v", tt.policy, err)
+		}
+		if got != tt.want || changed != tt.changed {
+			t.Errorf("removePublicReadResource(%s) = %s %v, want %s %v", tt.policy, got, changed, tt.want, tt.changed)
+		}
+	}
+	if _, _, err := removePublicReadResource("not json", resource); err == nil {
+		t.Error("removePublicReadResource accepted an invalid policy")
+	}
+}
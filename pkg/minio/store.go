@@ -0,0 +1,75 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// 对象存储后端
+const (
+	BackendMinio = "minio" // Minio 或兼容 S3 协议的对象存储
+	BackendLocal = "local" // 本地磁盘，经 API 服务访问，用于开发及测试
+)
+
+// ObjectInfo 对象的元信息
+type ObjectInfo struct {
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// ObjectPart 分片上传会话中已上传的分片
+type ObjectPart struct {
+	PartNumber int
+	ETag       string
+	Size       int64
+}
+
+// ObjectStore 对象存储，包级函数均委托给按配置选择的实现
+type ObjectStore interface {
+	// CreateBucket 创建存储桶，已存在时不报错
+	CreateBucket(ctx context.Context, bucketName string) error
+	// Put 写入对象，返回写入的字节数，size 为 -1 时读取至 EOF
+	Put(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, contentType string) (int64, error)
+	// PutFile 将本地文件写入对象
+	PutFile(ctx context.Context, bucketName, objectName, path, contentType string) (int64, error)
+	// Get 读取对象，调用方负责关闭
+	Get(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error)
+	// Stat 获取对象的元信息
+	Stat(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error)
+	// Remove 删除对象，对象不存在时不报错
+	Remove(ctx context.Context, bucketName, objectName string) error
+	// RemovePrefix 删除指定前缀下的全部对象
+	RemovePrefix(ctx context.Context, bucketName, prefix string) error
+	// PresignGet 生成指定有效期的临时读取链接
+	PresignGet(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error)
+	// SetPublicReadPrefix 允许匿名读取指定前缀下的对象
+	SetPublicReadPrefix(ctx context.Context, bucketName, prefix string) error
+	// RemovePublicReadPrefix 取消指定前缀的匿名读取，未设置时不报错
+	RemovePublicReadPrefix(ctx context.Context, bucketName, prefix string) error
+	// NewMultipartUpload 创建分片上传会话，返回 uploadID
+	NewMultipartUpload(ctx context.Context, bucketName, objectName, contentType string) (string, error)
+	// UploadPart 上传单个分片，返回该分片的 ETag
+	UploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (string, error)
+	// ListParts 获取已上传的全部分片
+	ListParts(ctx context.Context, bucketName, objectName, uploadID string) ([]ObjectPart, error)
+	// CompleteMultipartUpload 按分片序号合并给定分片
+	CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []ObjectPart) error
+	// AbortMultipartUpload 取消分片上传会话，并清理已上传的分片
+	AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error
+}
+
+// NewObjectStore 按后端名称创建对象存储，为空时使用 Minio
+func NewObjectStore(backend string) (ObjectStore, error) {
+	switch backend {
+	case BackendMinio, "":
+		return NewMinioStore(MinioEndPoint, MinioAccessKeyId, MinioSecretAccessKey, UseSSL)
+	case BackendLocal:
+		return NewLocalStore(LocalRoot, LocalBaseURL, []byte(minioConfig.Viper.GetString("minio.Local.SignKey")))
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", backend)
+	}
+}
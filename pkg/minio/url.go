@@ -0,0 +1,161 @@
+package minio
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 媒体链接的生成方式
+const (
+	URLStrategyPresigned = "presigned" // Minio 预签名链接
+	URLStrategyPublic    = "public"    // 公共读存储桶的永久链接
+	URLStrategyCDN       = "cdn"       // 带鉴权参数的 CDN 链接
+)
+
+// MediaURLResolver 将存储桶中的对象转换为客户端可访问的链接
+type MediaURLResolver interface {
+	// Resolve 返回对象的访问链接及其过期时间，永久链接的过期时间为零值
+	Resolve(bucketName, objectName string) (string, time.Time, error)
+}
+
+// PresignedResolver 生成对象存储的预签名链接
+type PresignedResolver struct {
+	Expiry time.Duration
+}
+
+func (r *PresignedResolver) Resolve(bucketName, objectName string) (string, time.Time, error) {
+	expireAt := time.Now().Add(r.Expiry)
+	u, err := presignedURL(bucketName, objectName, r.Expiry)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return u, expireAt, nil
+}
+
+// PublicResolver 拼接公共读存储桶的永久链接，BaseURL 可指向 Minio 或回源至 Minio 的 CDN
+type PublicResolver struct {
+	BaseURL string
+}
+
+func (r *PublicResolver) Resolve(bucketName, objectName string) (string, time.Time, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return "", time.Time{}, errors.New("invalid argument")
+	}
+	return strings.TrimSuffix(r.BaseURL, "/") + objectPath(bucketName, objectName), time.Time{}, nil
+}
+
+// CDNResolver 生成带 A 类鉴权参数的 CDN 链接：
+// auth_key={timestamp}-{rand}-{uid}-{md5("{path}-{timestamp}-{rand}-{uid}-{key}")}，timestamp 为链接的过期时间
+type CDNResolver struct {
+	BaseURL string
+	AuthKey string
+	Expiry  time.Duration
+}
+
+func (r *CDNResolver) Resolve(bucketName, objectName string) (string, time.Time, error) {
+	if len(bucketName) <= 0 || len(objectName) <= 0 {
+		return "", time.Time{}, errors.New("invalid argument")
+	}
+	expireAt := time.Now().Add(r.Expiry)
+	p := objectPath(bucketName, objectName)
+	ts := expireAt.Unix()
+	sum := md5.Sum([]byte(fmt.Sprintf("%s-%d-0-0-%s", p, ts, r.AuthKey)))
+	authKey := fmt.Sprintf("%d-0-0-%s", ts, hex.EncodeToString(sum[:]))
+	return strings.TrimSuffix(r.BaseURL, "/") + p + "?auth_key=" + authKey, expireAt, nil
+}
+
+// objectPath 生成对象的转义路径
+func objectPath(bucketName, objectName string) string {
+	return (&url.URL{Path: "/" + bucketName + "/" + objectName}).EscapedPath()
+}
+
+type cachedURL struct {
+	url       string
+	refreshAt time.Time
+}
+
+// CachedResolver 缓存有过期时间的链接，在剩余有效期不足 RefreshBefore 时重新生成，永久链接不过期
+type CachedResolver struct {
+	Resolver      MediaURLResolver
+	RefreshBefore time.Duration
+	Limit         int
+
+	mu   sync.RWMutex
+	urls map[string]cachedURL
+}
+
+// NewCachedResolver 创建带缓存的链接生成器
+func NewCachedResolver(resolver MediaURLResolver, refreshBefore time.Duration, limit int) *CachedResolver {
+	return &CachedResolver{
+		Resolver:      resolver,
+		RefreshBefore: refreshBefore,
+		Limit:         limit,
+		urls:          make(map[string]cachedURL),
+	}
+}
+
+func (c *CachedResolver) Resolve(bucketName, objectName string) (string, time.Time, error) {
+	key := bucketName + "/" + objectName
+	now := time.Now()
+
+	c.mu.RLock()
+	u, ok := c.urls[key]
+	c.mu.RUnlock()
+	if ok && (u.refreshAt.IsZero() || now.Before(u.refreshAt)) {
+		return u.url, u.refreshAt, nil
+	}
+
+	link, expireAt, err := c.Resolver.Resolve(bucketName, objectName)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	refreshAt := expireAt
+	if !expireAt.IsZero() {
+		refreshAt = expireAt.Add(-c.RefreshBefore)
+	}
+	c.mu.Lock()
+	// 缓存数量超出上限时清理需要刷新的链接，仍超出则整体清空
+	if len(c.urls) >= c.Limit {
+		for k, v := range c.urls {
+			if !v.refreshAt.IsZero() && !now.Before(v.refreshAt) {
+				delete(c.urls, k)
+			}
+		}
+		if len(c.urls) >= c.Limit {
+			c.urls = make(map[string]cachedURL)
+		}
+	}
+	c.urls[key] = cachedURL{url: link, refreshAt: refreshAt}
+	c.mu.Unlock()
+	return link, expireAt, nil
+}
+
+// NewMediaURLResolver 根据生成方式创建媒体链接生成器
+func NewMediaURLResolver(strategy string) (MediaURLResolver, error) {
+	switch strategy {
+	case "", URLStrategyPresigned:
+		return &PresignedResolver{Expiry: time.Duration(ExpireTime) * time.Second}, nil
+	case URLStrategyPublic:
+		return &PublicResolver{BaseURL: PublicBaseURL}, nil
+	case URLStrategyCDN:
+		return &CDNResolver{
+			BaseURL: minioConfig.Viper.GetString("minio.CDN.BaseURL"),
+			AuthKey: minioConfig.Viper.GetString("minio.CDN.AuthKey"),
+			Expiry:  time.Duration(minioConfig.Viper.GetUint32("minio.CDN.ExpireTime")) * time.Second,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown media url strategy %q", strategy)
+	}
+}
+
+// MediaURL 使用配置的生成方式获取对象返回给客户端的访问链接，有过期时间的链接在进程内缓存复用
+func MediaURL(bucketName, objectName string) (string, error) {
+	u, _, err := mediaURLResolver.Resolve(bucketName, objectName)
+	return u, err
+}
@@ -0,0 +1,64 @@
+package minio
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCDNResolver(t *testing.T) {
+	r := &CDNResolver{BaseURL: "https://cdn.example.com/", AuthKey: "key", Expiry: time.Hour}
+	u, expireAt, err := r.Resolve("covers", "12/a b.jpg")
+	ExpectEqual(err, nil, t)
+	if !strings.HasPrefix(u, "https://cdn.example.com/covers/12/a%20b.jpg?auth_key=") {
+		t.Fatalf("unexpected cdn url %q", u)
+	}
+	if d := time.Until(expireAt); d <= 59*time.Minute || d > time.Hour {
+		t.Fatalf("unexpected expiry %v", d)
+	}
+	_, _, err = r.Resolve("", "object")
+	ExpectUnEqual(err, nil, t)
+}
+
+type countingResolver struct {
+	calls  int
+	expiry time.Duration
+}
+
+func (r *countingResolver) Resolve(bucketName, objectName string) (string, time.Time, error) {
+	r.calls++
+	if objectName == "" {
+		return "", time.Time{}, errors.New("invalid argument")
+	}
+	var expireAt time.Time
+	if r.expiry > 0 {
+		expireAt = time.Now().Add(r.expiry)
+	}
+	return bucketName + "/" + objectName, expireAt, nil
+}
+
+func TestCachedResolver(t *testing.T) {
+	// 剩余有效期充足时复用缓存
+	inner := &countingResolver{expiry: time.Hour}
+	c := NewCachedResolver(inner, time.Minute, 2)
+	c.Resolve("b", "o")
+	c.Resolve("b", "o")
+	ExpectEqual(inner.calls, 1, t)
+
+	// 剩余有效期不足时重新签名
+	inner = &countingResolver{expiry: time.Minute}
+	c = NewCachedResolver(inner, time.Minute, 2)
+	c.Resolve("b", "o")
+	c.Resolve("b", "o")
+	ExpectEqual(inner.calls, 2, t)
+
+	// 永久链接不过期，出错时不缓存
+	inner = &countingResolver{}
+	c = NewCachedResolver(inner, time.Minute, 2)
+	c.Resolve("b", "o")
+	c.Resolve("b", "o")
+	_, _, err := c.Resolve("b", "")
+	ExpectUnEqual(err, nil, t)
+	ExpectEqual(inner.calls, 2, t)
+}
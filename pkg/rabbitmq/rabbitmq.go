@@ -27,6 +27,11 @@ type RabbitMQ struct {
 	notifyClose   chan *amqp.Error       // 如果异常关闭，会接收数据
 	notifyConfirm chan amqp.Confirmation // 消息发送成功确认，会接收到数据
 	prefetchCount int
+	autoAck       bool
+	// 队列是否持久化，持久化队列中的消息同样会被持久化
+	durable bool
+	// 申请队列时的额外属性，如死信队列配置
+	args amqp.Table
 }
 
 // 创建结构体实例
@@ -52,6 +57,7 @@ func (r *RabbitMQ) failOnErr(err error, message string) {
 func NewRabbitMQSimple(queueName string, autoAck bool) *RabbitMQ {
 	// 创建RabbitMQ实例
 	rabbitmq := NewRabbitMQ(queueName, "", "", config.Viper.GetInt("server.prefetchCount"))
+	rabbitmq.autoAck = autoAck
 	var err error
 	// 获取connection
 	rabbitmq.conn, err = amqp.Dial(rabbitmq.Mqurl)
@@ -70,13 +76,40 @@ func NewRabbitMQSimple(queueName string, autoAck bool) *RabbitMQ {
 	return rabbitmq
 }
 
+// NewRabbitMQDeadLetter 创建带死信队列的持久化队列实例，被消费者拒绝且不重新入队的消息将转入死信队列
+func NewRabbitMQDeadLetter(queueName string, deadLetterQueue string, autoAck bool) *RabbitMQ {
+	rabbitmq := NewRabbitMQSimple(queueName, autoAck)
+	rabbitmq.durable = true
+	// 申请死信队列
+	_, err := rabbitmq.channel.QueueDeclare(
+		deadLetterQueue,
+		// 是否持久化
+		true,
+		// 是否自动删除
+		false,
+		// 是否具有排他性
+		false,
+		// 是否阻塞处理
+		false,
+		// 额外的属性
+		nil,
+	)
+	rabbitmq.failOnErr(err, "failed to declare a dead letter queue")
+	rabbitmq.args = amqp.Table{
+		// 使用默认交换机，按队列名路由至死信队列
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": deadLetterQueue,
+	}
+	return rabbitmq
+}
+
 // PublishSimple 简单模式队列生产
 func (r *RabbitMQ) PublishSimple(ctx context.Context, message []byte) error {
 	//1.申请队列，如果队列不存在会自动创建，存在则跳过创建
 	_, err := r.channel.QueueDeclare(
 		r.QueueName,
 		// 是否持久化
-		false,
+		r.durable,
 		// 是否自动删除
 		false,
 		// 是否具有排他性
@@ -84,7 +117,7 @@ func (r *RabbitMQ) PublishSimple(ctx context.Context, message []byte) error {
 		// 是否阻塞处理
 		false,
 		// 额外的属性
-		nil,
+		r.args,
 	)
 	if err != nil {
 		fmt.Println(err)
@@ -95,6 +128,11 @@ func (r *RabbitMQ) PublishSimple(ctx context.Context, message []byte) error {
 		}
 		return err
 	}
+	// 持久化队列中的消息同样需要持久化，避免 RabbitMQ 重启后丢失
+	deliveryMode := amqp.Transient
+	if r.durable {
+		deliveryMode = amqp.Persistent
+	}
 	// 调用channel 发送消息到队列中
 	err = r.channel.PublishWithContext(
 		ctx,
@@ -105,9 +143,10 @@ func (r *RabbitMQ) PublishSimple(ctx context.Context, message []byte) error {
 		// 如果为true，当exchange发送消息到队列后发现队列上没有消费者，则会把消息返还给发送者
 		false,
 		amqp.Publishing{
-			ContentType: "application/json", //设置消息请求头为json
-			Body:        message,
-			Timestamp:   time.Now(),
+			ContentType:  "application/json", //设置消息请求头为json
+			Body:         message,
+			Timestamp:    time.Now(),
+			DeliveryMode: deliveryMode,
 		})
 	if err != nil {
 		logger.Errorf("MQ 生产者错误：%v", err.Error())
@@ -126,7 +165,7 @@ func (r *RabbitMQ) ConsumeSimple() (<-chan amqp.Delivery, error) {
 	q, err := r.channel.QueueDeclare(
 		r.QueueName,
 		// 是否持久化
-		false,
+		r.durable,
 		// 是否自动删除
 		false,
 		// 是否具有排他性
@@ -134,7 +173,7 @@ func (r *RabbitMQ) ConsumeSimple() (<-chan amqp.Delivery, error) {
 		// 是否阻塞处理
 		false,
 		// 额外的属性
-		nil,
+		r.args,
 	)
 	if err != nil {
 		logger.Errorf("MQ 消费者错误：%v", err.Error())
@@ -147,7 +186,7 @@ func (r *RabbitMQ) ConsumeSimple() (<-chan amqp.Delivery, error) {
 		// 用来区分多个消费者
 		"", // consumer
 		// 是否自动应答
-		config.Viper.GetBool("consumer.favorite.autoAck"), // auto-ack
+		r.autoAck, // auto-ack
 		// 是否独有
 		false, // exclusive
 		// 设置为true，表示 不能将同一个Connection中生产者发送的消息传递给这个Connection中的消费者
@@ -167,7 +206,7 @@ func (r *RabbitMQ) DeclareQueue() error {
 	q, err := r.channel.QueueDeclare(
 		r.QueueName,
 		//是否持久化
-		false,
+		r.durable,
 		//是否自动删除
 		false,
 		//是否具有排他性
@@ -175,7 +214,7 @@ func (r *RabbitMQ) DeclareQueue() error {
 		//是否阻塞处理
 		false,
 		//额外的属性
-		nil,
+		r.args,
 	)
 	if err != nil {
 		logger.Errorln(err.Error())
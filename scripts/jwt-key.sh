@@ -0,0 +1,26 @@
+# 生成 JWT 签名密钥，用法：sh scripts/jwt-key.sh [kid] [ed25519|rsa]
+# 公钥写入 config/jwt/{kid}.pub.pem，需分发给所有服务；私钥写入 config/jwt/private/{kid}.pem，仅用户服务持有
+# 密钥已存在时不会覆盖，startup.sh 每次启动前调用，首次启动时生成默认密钥
+# config/jwt/private/current.pem 为指向签名私钥的符号链接，不存在时指向本次的 kid
+# 轮换密钥：生成新密钥并分发公钥，待各服务重新加载后将 current.pem 指向新私钥，用户服务在下次重新加载时切换，无需重启；
+# 旧令牌全部过期后删除旧公钥
+kid=${1:-default}
+alg=${2:-ed25519}
+dir=$(dirname "$0")/../config/jwt
+
+mkdir -p "$dir/private"
+if [ ! -e "$dir/private/$kid.pem" ]; then
+    if [ "$alg" = "rsa" ]; then
+        openssl genpkey -algorithm RSA -pkeyopt rsa_keygen_bits:2048 -out "$dir/private/$kid.pem" || exit 1
+    else
+        openssl genpkey -algorithm ed25519 -out "$dir/private/$kid.pem" || exit 1
+    fi
+    chmod 600 "$dir/private/$kid.pem"
+    echo "generated $dir/private/$kid.pem"
+fi
+if [ ! -e "$dir/$kid.pub.pem" ]; then
+    openssl pkey -in "$dir/private/$kid.pem" -pubout -out "$dir/$kid.pub.pem" || exit 1
+fi
+if [ ! -e "$dir/private/current.pem" ]; then
+    ln -s "$kid.pem" "$dir/private/current.pem" || exit 1
+fi
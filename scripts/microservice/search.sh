@@ -0,0 +1 @@
+go run ../../cmd/search/main.go
\ No newline at end of file
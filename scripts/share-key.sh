@@ -0,0 +1,11 @@
+# 生成分享码的签名密钥，用法：sh scripts/share-key.sh
+# 密钥写入 config/secret/share.key，仅视频服务持有；密钥已存在时跳过，更换密钥后已生成的分享码全部失效
+dir=$(dirname "$0")/../config/secret
+
+mkdir -p "$dir"
+if [ -e "$dir/share.key" ]; then
+    echo "$dir/share.key already exists, skip"
+    exit 0
+fi
+openssl rand -base64 32 > "$dir/share.key"
+chmod 600 "$dir/share.key"
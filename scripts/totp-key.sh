@@ -0,0 +1,11 @@
+# 生成两步验证的加密密钥，用法：sh scripts/totp-key.sh
+# 密钥写入 config/secret/totp.key，仅用户服务持有；密钥已存在时跳过，更换密钥后已绑定的验证器及恢复码全部失效
+dir=$(dirname "$0")/../config/secret
+
+mkdir -p "$dir"
+if [ -e "$dir/totp.key" ]; then
+    echo "$dir/totp.key already exists, skip"
+    exit 0
+fi
+openssl rand -base64 32 > "$dir/totp.key"
+chmod 600 "$dir/totp.key"
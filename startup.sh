@@ -1,5 +1,10 @@
 session_name=dousheng
 
+# 首次启动时生成 JWT 签名密钥、两步验证的加密密钥及分享码签名密钥，已存在时跳过
+sh scripts/jwt-key.sh || exit 1
+sh scripts/totp-key.sh || exit 1
+sh scripts/share-key.sh || exit 1
+
 tmux has-session -t $session_name
 if [ $? != 0 ];then
     path_to_scrpit=scripts/microservice
@@ -17,9 +22,11 @@ if [ $? != 0 ];then
     tmux send-keys -t $session_name:4 'sh user.sh' C-m
     tmux new-window -n video -t $session_name
     tmux send-keys -t $session_name:5 'sh video.sh' C-m
+    tmux new-window -n search -t $session_name
+    tmux send-keys -t $session_name:6 'sh search.sh' C-m
     tmux new-window -n api -t $session_name
-    tmux send-keys -t $session_name:6 'sh api.sh' C-m
-    tmux select-window -t $session_name:6
+    tmux send-keys -t $session_name:7 'sh api.sh' C-m
+    tmux select-window -t $session_name:7
 fi
 tmux attach -t dousheng
 echo "tmux has started."
\ No newline at end of file